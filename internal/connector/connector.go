@@ -0,0 +1,94 @@
+// Package connector is the pluggable-backend registry for the auth
+// service: identity and storage backends (LDAP, SQL, a static user
+// file, ...) register a Factory under a type name, and a deployment
+// selects which ones to load at startup from a config section like:
+//
+//	connectors:
+//	  - name: ldap-employees
+//	    type: ldap
+//	    config: {url: "ldaps://dc1.example.com", base_dn: "dc=example,dc=com"}
+//	  - name: gh-contractors
+//	    type: github_oauth
+//	    config: {client_id: "...", client_secret: "..."}
+//
+// A single connector instance may implement one or more of UserStore,
+// ResourceStore, and IdentityConnector (see interfaces.go); the caller
+// type-asserts to whichever it needs. Built-in connectors register
+// themselves via an init() in their own package — see
+// internal/connector/ldap, internal/connector/sql, and
+// internal/connector/staticfile.
+package connector
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Config describes one configured connector instance.
+type Config struct {
+	// Name identifies this instance, e.g. "ldap-employees". Login
+	// requests that need a specific identity connector address it by
+	// this name as connector_id.
+	Name string
+	// Type selects the registered Factory, e.g. "ldap".
+	Type string
+	// Config is the connector-type-specific configuration, decoded by
+	// the Factory itself.
+	Config map[string]any
+}
+
+// Factory constructs a connector instance from its type-specific config.
+// The returned value is typically type-asserted by the caller to
+// UserStore, ResourceStore, and/or IdentityConnector.
+type Factory func(cfg map[string]any) (any, error)
+
+var (
+	mu        sync.RWMutex
+	factories = map[string]Factory{}
+)
+
+// Register adds a Factory under type name. It is meant to be called from
+// an init() in the connector's own package; registering the same name
+// twice is a programming error and panics, matching the pattern
+// database/sql's driver registry uses.
+func Register(typeName string, factory Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, exists := factories[typeName]; exists {
+		panic(fmt.Sprintf("connector: Register called twice for type %q", typeName))
+	}
+	factories[typeName] = factory
+}
+
+// New builds a connector instance from cfg using the Factory registered
+// under cfg.Type.
+func New(cfg Config) (any, error) {
+	mu.RLock()
+	factory, ok := factories[cfg.Type]
+	mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("connector: unknown type %q for connector %q", cfg.Type, cfg.Name)
+	}
+	instance, err := factory(cfg.Config)
+	if err != nil {
+		return nil, fmt.Errorf("connector: construct %q (type %q): %w", cfg.Name, cfg.Type, err)
+	}
+	return instance, nil
+}
+
+// Load builds every configured connector and returns them keyed by name.
+// A deployment can then type-assert each instance to the UserStore,
+// ResourceStore, and/or IdentityConnector interfaces it needs — see the
+// package doc for how a single connector can satisfy more than one.
+func Load(configs []Config) (map[string]any, error) {
+	instances := make(map[string]any, len(configs))
+	for _, cfg := range configs {
+		instance, err := New(cfg)
+		if err != nil {
+			return nil, err
+		}
+		instances[cfg.Name] = instance
+	}
+	return instances, nil
+}
@@ -0,0 +1,78 @@
+package connector
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned by a UserStore/ResourceStore connector when a
+// lookup matches nothing, so callers (user.Service, resource.Service)
+// can map it onto their own package-level ErrNotFound regardless of
+// which connector is actually loaded.
+var ErrNotFound = errors.New("connector: not found")
+
+// User is a local account record, mirroring internal/service/user.User.
+// It is duplicated here (rather than imported) so this package stays a
+// leaf: connectors register themselves independently of the service
+// layer that will eventually wire them in.
+type User struct {
+	ID                 string
+	Username           string
+	Email              string
+	PasswordHash       string
+	ExternalIdentities map[string]string
+}
+
+// UserStore is the persistence backend a user.Service delegates to.
+type UserStore interface {
+	Get(ctx context.Context, id string) (*User, error)
+	GetByExternalIdentity(ctx context.Context, provider, subject string) (*User, error)
+	Create(ctx context.Context, u *User) error
+	Update(ctx context.Context, u *User) error
+}
+
+// Policy mirrors internal/service/resource.Policy.
+type Policy struct {
+	ID         string
+	Subject    string
+	Service    string
+	Resource   string
+	Action     string
+	Effect     string
+	Conditions []string
+}
+
+// ResourceStore is the persistence backend a resource.Service delegates
+// to.
+type ResourceStore interface {
+	Get(ctx context.Context, id string) (*Policy, error)
+	List(ctx context.Context, service string) ([]*Policy, error)
+	Create(ctx context.Context, p *Policy) error
+	Update(ctx context.Context, p *Policy) error
+	Delete(ctx context.Context, id string) error
+}
+
+// Credentials is the input to an IdentityConnector login attempt.
+// Connectors that don't use username/password (e.g. a federated OAuth
+// provider) ignore the fields they don't need.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// Identity is the normalized profile an IdentityConnector returns after
+// successful authentication.
+type Identity struct {
+	Subject string
+	Email   string
+	Name    string
+}
+
+// IdentityConnector authenticates a set of Credentials against an
+// external source of truth (LDAP, a federated OAuth provider, ...)
+// without owning the local user record itself — the
+// caller still resolves/provisions a local user from the returned
+// Identity, same as auth.Provider callers do for OAuth logins.
+type IdentityConnector interface {
+	Authenticate(ctx context.Context, creds Credentials) (Identity, error)
+}
@@ -0,0 +1,102 @@
+// Package ldap implements an IdentityConnector that authenticates
+// credentials by binding to an LDAP/Active Directory server.
+package ldap
+
+import (
+	"context"
+	"fmt"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	"github.com/go-sso-example/auth-service/internal/connector"
+)
+
+func init() {
+	connector.Register("ldap", New)
+}
+
+// Config is the "config" section of an `ldap`-typed connector entry.
+type Config struct {
+	URL         string `json:"url"`
+	BaseDN      string `json:"base_dn"`
+	UserFilter  string `json:"user_filter"`  // e.g. "(uid=%s)"
+	EmailAttr   string `json:"email_attr"`   // default "mail"
+	DisplayAttr string `json:"display_attr"` // default "displayName"
+}
+
+// Connector authenticates credentials against an LDAP directory by
+// searching for the user's entry, then binding as that entry with the
+// supplied password.
+type Connector struct {
+	cfg Config
+}
+
+// New constructs an LDAP Connector from its raw config map.
+func New(raw map[string]any) (any, error) {
+	cfg, err := decodeConfig(raw)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.UserFilter == "" {
+		cfg.UserFilter = "(uid=%s)"
+	}
+	if cfg.EmailAttr == "" {
+		cfg.EmailAttr = "mail"
+	}
+	if cfg.DisplayAttr == "" {
+		cfg.DisplayAttr = "displayName"
+	}
+	return &Connector{cfg: cfg}, nil
+}
+
+// Authenticate searches BaseDN for an entry matching UserFilter with
+// creds.Username substituted in, then re-binds as that entry's DN with
+// creds.Password to verify it.
+func (c *Connector) Authenticate(ctx context.Context, creds connector.Credentials) (connector.Identity, error) {
+	conn, err := goldap.DialURL(c.cfg.URL)
+	if err != nil {
+		return connector.Identity{}, fmt.Errorf("ldap: dial %s: %w", c.cfg.URL, err)
+	}
+	defer conn.Close()
+
+	searchReq := goldap.NewSearchRequest(
+		c.cfg.BaseDN,
+		goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, 0, false,
+		fmt.Sprintf(c.cfg.UserFilter, goldap.EscapeFilter(creds.Username)),
+		[]string{"dn", c.cfg.EmailAttr, c.cfg.DisplayAttr},
+		nil,
+	)
+	result, err := conn.Search(searchReq)
+	if err != nil {
+		return connector.Identity{}, fmt.Errorf("ldap: search: %w", err)
+	}
+	if len(result.Entries) != 1 {
+		return connector.Identity{}, fmt.Errorf("ldap: expected 1 entry for %q, found %d", creds.Username, len(result.Entries))
+	}
+	entry := result.Entries[0]
+
+	if err := conn.Bind(entry.DN, creds.Password); err != nil {
+		return connector.Identity{}, fmt.Errorf("ldap: bind as %s: %w", entry.DN, err)
+	}
+
+	return connector.Identity{
+		Subject: entry.DN,
+		Email:   entry.GetAttributeValue(c.cfg.EmailAttr),
+		Name:    entry.GetAttributeValue(c.cfg.DisplayAttr),
+	}, nil
+}
+
+func decodeConfig(raw map[string]any) (Config, error) {
+	var cfg Config
+	url, _ := raw["url"].(string)
+	baseDN, _ := raw["base_dn"].(string)
+	if url == "" || baseDN == "" {
+		return Config{}, fmt.Errorf("ldap: \"url\" and \"base_dn\" are required")
+	}
+	cfg.URL = url
+	cfg.BaseDN = baseDN
+	cfg.UserFilter, _ = raw["user_filter"].(string)
+	cfg.EmailAttr, _ = raw["email_attr"].(string)
+	cfg.DisplayAttr, _ = raw["display_attr"].(string)
+	return cfg, nil
+}
@@ -0,0 +1,211 @@
+// Package sql implements UserStore and ResourceStore backed by a
+// database/sql connection, for deployments that want their users and
+// policies in the same relational database as everything else instead
+// of a dedicated identity service.
+//
+// The two stores are registered under separate connector types
+// ("sql_users" and "sql_resources") rather than one combined type,
+// since connector.UserStore and connector.ResourceStore both declare a
+// Get method with a different return type — one Go type can't implement
+// both. A deployment that wants both configures two connector entries
+// against the same database.
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/go-sso-example/auth-service/internal/connector"
+)
+
+func init() {
+	connector.Register("sql_users", NewUserStore)
+	connector.Register("sql_resources", NewPolicyStore)
+}
+
+// Config is the "config" section of a `sql_users`/`sql_resources`-typed
+// connector entry.
+type Config struct {
+	Driver string `json:"driver"` // e.g. "postgres"
+	DSN    string `json:"dsn"`
+}
+
+func openDB(raw map[string]any) (*sql.DB, error) {
+	driver, _ := raw["driver"].(string)
+	dsn, _ := raw["dsn"].(string)
+	if driver == "" || dsn == "" {
+		return nil, fmt.Errorf("sql: \"driver\" and \"dsn\" are required")
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("sql: open %s: %w", driver, err)
+	}
+	return db, nil
+}
+
+// UserStore implements connector.UserStore over a "users" /
+// "user_external_identities" schema.
+type UserStore struct {
+	db *sql.DB
+}
+
+// NewUserStore opens a UserStore from its raw config map.
+func NewUserStore(raw map[string]any) (any, error) {
+	db, err := openDB(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &UserStore{db: db}, nil
+}
+
+func (s *UserStore) Get(ctx context.Context, id string) (*connector.User, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT id, username, email, password_hash FROM users WHERE id = $1`, id)
+	return scanUser(row)
+}
+
+func (s *UserStore) GetByExternalIdentity(ctx context.Context, provider, subject string) (*connector.User, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT u.id, u.username, u.email, u.password_hash
+		FROM users u
+		JOIN user_external_identities x ON x.user_id = u.id
+		WHERE x.provider = $1 AND x.subject = $2`, provider, subject)
+	return scanUser(row)
+}
+
+func (s *UserStore) Create(ctx context.Context, u *connector.User) error {
+	return s.db.QueryRowContext(ctx, `
+		INSERT INTO users (username, email, password_hash) VALUES ($1, $2, $3)
+		RETURNING id`, u.Username, u.Email, u.PasswordHash).Scan(&u.ID)
+}
+
+func (s *UserStore) Update(ctx context.Context, u *connector.User) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE users SET username = $1, email = $2, password_hash = $3 WHERE id = $4`,
+		u.Username, u.Email, u.PasswordHash, u.ID)
+	return err
+}
+
+func scanUser(row *sql.Row) (*connector.User, error) {
+	var u connector.User
+	var passwordHash sql.NullString
+	if err := row.Scan(&u.ID, &u.Username, &u.Email, &passwordHash); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connector.ErrNotFound
+		}
+		return nil, err
+	}
+	u.PasswordHash = passwordHash.String
+	return &u, nil
+}
+
+// PolicyStore implements connector.ResourceStore over a "policies"
+// table.
+type PolicyStore struct {
+	db *sql.DB
+}
+
+// NewPolicyStore opens a PolicyStore from its raw config map.
+func NewPolicyStore(raw map[string]any) (any, error) {
+	db, err := openDB(raw)
+	if err != nil {
+		return nil, err
+	}
+	return &PolicyStore{db: db}, nil
+}
+
+func (s *PolicyStore) Get(ctx context.Context, id string) (*connector.Policy, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id, subject, service, resource, action, effect, conditions FROM policies WHERE id = $1`, id)
+	return scanPolicy(row)
+}
+
+func (s *PolicyStore) List(ctx context.Context, service string) ([]*connector.Policy, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, subject, service, resource, action, effect, conditions FROM policies WHERE service = $1`, service)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []*connector.Policy
+	for rows.Next() {
+		p, err := scanPolicyRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	return policies, rows.Err()
+}
+
+func (s *PolicyStore) Create(ctx context.Context, p *connector.Policy) error {
+	conditions, err := marshalConditions(p.Conditions)
+	if err != nil {
+		return err
+	}
+	return s.db.QueryRowContext(ctx, `
+		INSERT INTO policies (subject, service, resource, action, effect, conditions) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`, p.Subject, p.Service, p.Resource, p.Action, p.Effect, conditions).Scan(&p.ID)
+}
+
+func (s *PolicyStore) Update(ctx context.Context, p *connector.Policy) error {
+	conditions, err := marshalConditions(p.Conditions)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `
+		UPDATE policies SET subject = $1, service = $2, resource = $3, action = $4, effect = $5, conditions = $6 WHERE id = $7`,
+		p.Subject, p.Service, p.Resource, p.Action, p.Effect, conditions, p.ID)
+	return err
+}
+
+func (s *PolicyStore) Delete(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM policies WHERE id = $1`, id)
+	return err
+}
+
+// rowScanner is the subset of *sql.Row / *sql.Rows that scanPolicyRow
+// needs, so Get (one row) and List (many rows) share the same scan
+// logic.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPolicy(row *sql.Row) (*connector.Policy, error) {
+	p, err := scanPolicyRow(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connector.ErrNotFound
+	}
+	return p, err
+}
+
+func scanPolicyRow(row rowScanner) (*connector.Policy, error) {
+	var p connector.Policy
+	var conditions sql.NullString
+	if err := row.Scan(&p.ID, &p.Subject, &p.Service, &p.Resource, &p.Action, &p.Effect, &conditions); err != nil {
+		return nil, err
+	}
+	if conditions.Valid && conditions.String != "" {
+		if err := json.Unmarshal([]byte(conditions.String), &p.Conditions); err != nil {
+			return nil, fmt.Errorf("sql: decode conditions for policy %s: %w", p.ID, err)
+		}
+	}
+	return &p, nil
+}
+
+// marshalConditions encodes Conditions as a JSON array for the
+// "conditions" column, so Create/Update round-trip the same condition
+// expressions Get/List decode back out.
+func marshalConditions(conditions []string) (string, error) {
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(conditions)
+	if err != nil {
+		return "", fmt.Errorf("sql: encode conditions: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,141 @@
+// Package staticfile implements connector.UserStore over a fixed
+// username/password list loaded from a YAML file at startup, for small
+// deployments or local development that don't want a database just to
+// hold a handful of accounts. It does not implement
+// connector.IdentityConnector, so it cannot authenticate a login on its
+// own.
+package staticfile
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	"github.com/go-sso-example/auth-service/internal/connector"
+)
+
+func init() {
+	connector.Register("static_file", New)
+}
+
+// fileUser is one entry in the static file's "users" list.
+type fileUser struct {
+	ID                 string            `yaml:"id"`
+	Username           string            `yaml:"username"`
+	Email              string            `yaml:"email"`
+	PasswordHash       string            `yaml:"password_hash"`
+	ExternalIdentities map[string]string `yaml:"external_identities"`
+}
+
+type fileContents struct {
+	Users []fileUser `yaml:"users"`
+}
+
+// Store is a read-mostly UserStore backed by an in-memory snapshot of a
+// YAML file. Create/Update mutate the in-memory copy only; this
+// connector is meant for a small, operator-managed user list, not
+// self-service account creation.
+type Store struct {
+	mu    sync.RWMutex
+	path  string
+	byID  map[string]*connector.User
+	byExt map[string]*connector.User // keyed by "provider\x1fsubject"
+}
+
+// New loads a Store from the YAML file named by raw["path"].
+func New(raw map[string]any) (any, error) {
+	path, _ := raw["path"].(string)
+	if path == "" {
+		return nil, fmt.Errorf("static_file: \"path\" is required")
+	}
+
+	s := &Store{path: path}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Store) reload() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fmt.Errorf("static_file: read %s: %w", s.path, err)
+	}
+
+	var contents fileContents
+	if err := yaml.Unmarshal(data, &contents); err != nil {
+		return fmt.Errorf("static_file: parse %s: %w", s.path, err)
+	}
+
+	byID := make(map[string]*connector.User, len(contents.Users))
+	byExt := make(map[string]*connector.User)
+	for _, fu := range contents.Users {
+		u := &connector.User{
+			ID:                 fu.ID,
+			Username:           fu.Username,
+			Email:              fu.Email,
+			PasswordHash:       fu.PasswordHash,
+			ExternalIdentities: fu.ExternalIdentities,
+		}
+		byID[u.ID] = u
+		for provider, subject := range u.ExternalIdentities {
+			byExt[provider+"\x1f"+subject] = u
+		}
+	}
+
+	s.mu.Lock()
+	s.byID, s.byExt = byID, byExt
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Store) Get(ctx context.Context, id string) (*connector.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.byID[id]
+	if !ok {
+		return nil, connector.ErrNotFound
+	}
+	return u, nil
+}
+
+func (s *Store) GetByExternalIdentity(ctx context.Context, provider, subject string) (*connector.User, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	u, ok := s.byExt[provider+"\x1f"+subject]
+	if !ok {
+		return nil, connector.ErrNotFound
+	}
+	return u, nil
+}
+
+// Create adds u to the in-memory snapshot. It does not persist back to
+// the file: the static file is the source of truth, and an operator who
+// wants a new user edits it and lets Reload pick it up. u.ID is
+// generated when empty, since this store (unlike the sql connector) has
+// no natural ID source of its own to assign one.
+func (s *Store) Create(ctx context.Context, u *connector.User) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u.ID == "" {
+		u.ID = uuid.NewString()
+	}
+	s.byID[u.ID] = u
+	for provider, subject := range u.ExternalIdentities {
+		s.byExt[provider+"\x1f"+subject] = u
+	}
+	return nil
+}
+
+func (s *Store) Update(ctx context.Context, u *connector.User) error {
+	return s.Create(ctx, u)
+}
+
+// Reload re-reads the backing file, replacing the in-memory snapshot.
+func (s *Store) Reload() error {
+	return s.reload()
+}
@@ -0,0 +1,25 @@
+// Package authctx carries the authenticated subject through a request's
+// context.Context, shared by the HTTP mux and the gRPC interceptor so
+// both transports resolve "who is calling" the same way.
+package authctx
+
+import "context"
+
+type contextKey struct{}
+
+// Subject is the authenticated caller extracted from a request's bearer
+// token.
+type Subject struct {
+	ID string
+}
+
+// WithSubject returns a copy of ctx carrying subject.
+func WithSubject(ctx context.Context, subject Subject) context.Context {
+	return context.WithValue(ctx, contextKey{}, subject)
+}
+
+// SubjectFromContext returns the Subject carried by ctx, if any.
+func SubjectFromContext(ctx context.Context) (Subject, bool) {
+	s, ok := ctx.Value(contextKey{}).(Subject)
+	return s, ok
+}
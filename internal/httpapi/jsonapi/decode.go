@@ -0,0 +1,58 @@
+package jsonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// request is the envelope shape of an inbound JSON:API body: a single
+// resource object under "data".
+type request struct {
+	Data struct {
+		Type          string          `json:"type"`
+		ID            string          `json:"id"`
+		Attributes    json.RawMessage `json:"attributes"`
+		Relationships json.RawMessage `json:"relationships"`
+	} `json:"data"`
+}
+
+// Decode reads a JSON:API request body, validates it has a top-level
+// "data" object with a "type", and unmarshals "attributes" into attrs.
+// A body with no "attributes" is accepted and leaves attrs untouched.
+// It rejects unknown fields in attributes so typos surface as a 422
+// instead of being silently dropped.
+func Decode(r *http.Request, attrs any) (resourceType, id string, err error) {
+	defer r.Body.Close()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return "", "", NewError(http.StatusBadRequest, "Bad Request", "could not read request body")
+	}
+	if len(body) == 0 {
+		return "", "", NewError(http.StatusBadRequest, "Bad Request", `request body must contain a top-level "data" object`).WithPointer("")
+	}
+
+	var req request
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		return "", "", NewError(http.StatusBadRequest, "Bad Request", fmt.Sprintf("invalid JSON:API document: %v", err)).WithPointer("")
+	}
+	if req.Data.Type == "" {
+		return "", "", NewError(http.StatusUnprocessableEntity, "Unprocessable Entity", `"data.type" is required`).WithPointer("/data/type")
+	}
+	if len(req.Data.Attributes) == 0 {
+		return req.Data.Type, req.Data.ID, nil
+	}
+
+	attrDec := json.NewDecoder(bytes.NewReader(req.Data.Attributes))
+	attrDec.DisallowUnknownFields()
+	if err := attrDec.Decode(attrs); err != nil {
+		return "", "", NewError(http.StatusUnprocessableEntity, "Unprocessable Entity", fmt.Sprintf("invalid attributes: %v", err)).WithPointer("/data/attributes")
+	}
+
+	return req.Data.Type, req.Data.ID, nil
+}
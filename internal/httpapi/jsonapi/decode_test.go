@@ -0,0 +1,66 @@
+package jsonapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type testAttrs struct {
+	Name string `json:"name"`
+}
+
+func newDecodeRequest(body string) *http.Request {
+	return httptest.NewRequest(http.MethodPost, "/widgets", strings.NewReader(body))
+}
+
+func TestDecodeSuccess(t *testing.T) {
+	body := `{"data":{"type":"widgets","id":"1","attributes":{"name":"sprocket"}}}`
+
+	var attrs testAttrs
+	resourceType, id, err := Decode(newDecodeRequest(body), &attrs)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if resourceType != "widgets" || id != "1" {
+		t.Fatalf("Decode returned (%q, %q), want (\"widgets\", \"1\")", resourceType, id)
+	}
+	if attrs.Name != "sprocket" {
+		t.Fatalf("attrs.Name = %q, want \"sprocket\"", attrs.Name)
+	}
+}
+
+func TestDecodeMissingDataType(t *testing.T) {
+	body := `{"data":{"attributes":{"name":"sprocket"}}}`
+
+	var attrs testAttrs
+	if _, _, err := Decode(newDecodeRequest(body), &attrs); err == nil {
+		t.Fatal("expected an error when data.type is missing")
+	}
+}
+
+func TestDecodeRejectsUnknownEnvelopeField(t *testing.T) {
+	body := `{"data":{"type":"widgets","attributes":{"name":"sprocket"}},"unexpected":true}`
+
+	var attrs testAttrs
+	if _, _, err := Decode(newDecodeRequest(body), &attrs); err == nil {
+		t.Fatal("expected an error for an unknown top-level envelope field")
+	}
+}
+
+func TestDecodeRejectsUnknownAttribute(t *testing.T) {
+	body := `{"data":{"type":"widgets","attributes":{"name":"sprocket","color":"red"}}}`
+
+	var attrs testAttrs
+	if _, _, err := Decode(newDecodeRequest(body), &attrs); err == nil {
+		t.Fatal("expected an error for an unknown attribute field")
+	}
+}
+
+func TestDecodeEmptyBody(t *testing.T) {
+	var attrs testAttrs
+	if _, _, err := Decode(newDecodeRequest(""), &attrs); err == nil {
+		t.Fatal("expected an error for an empty request body")
+	}
+}
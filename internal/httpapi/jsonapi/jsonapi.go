@@ -0,0 +1,112 @@
+// Package jsonapi is the shared response envelope for every HTTP
+// handler in this service. It implements the JSON:API media type
+// (https://jsonapi.org/format/): a top-level {data, errors, meta, links}
+// document, resource objects with type/id/attributes/relationships, and
+// a standardized error object. Handlers build a Document or Error and
+// hand it to Write/WriteError instead of encoding JSON themselves, so
+// every endpoint returns the same shape.
+package jsonapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const MediaType = "application/vnd.api+json"
+
+// Document is the top-level JSON:API response body. Exactly one of Data
+// or Errors should be set per the spec.
+type Document struct {
+	Data   any            `json:"data,omitempty"`
+	Errors []*Error       `json:"errors,omitempty"`
+	Meta   map[string]any `json:"meta,omitempty"`
+	Links  map[string]any `json:"links,omitempty"`
+}
+
+// Resource is a single JSON:API resource object.
+type Resource struct {
+	Type          string                  `json:"type"`
+	ID            string                  `json:"id"`
+	Attributes    any                     `json:"attributes,omitempty"`
+	Relationships map[string]Relationship `json:"relationships,omitempty"`
+}
+
+// Relationship is a to-one or to-many JSON:API relationship.
+type Relationship struct {
+	Data  any            `json:"data,omitempty"`
+	Links map[string]any `json:"links,omitempty"`
+}
+
+// Error is a JSON:API error object.
+type Error struct {
+	Status string       `json:"status,omitempty"`
+	Code   string       `json:"code,omitempty"`
+	Title  string       `json:"title,omitempty"`
+	Detail string       `json:"detail,omitempty"`
+	Source *ErrorSource `json:"source,omitempty"`
+}
+
+// ErrorSource points an Error at the part of the request that caused it.
+type ErrorSource struct {
+	Pointer   string `json:"pointer,omitempty"`
+	Parameter string `json:"parameter,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if e.Detail != "" {
+		return e.Detail
+	}
+	return e.Title
+}
+
+// HTTPStatus returns the Error's Status as an int, defaulting to 500 if
+// it is missing or unparseable.
+func (e *Error) HTTPStatus() int {
+	status, err := statusFromString(e.Status)
+	if err != nil {
+		return http.StatusInternalServerError
+	}
+	return status
+}
+
+// NewError constructs an Error for the given HTTP status with a title
+// and detail message.
+func NewError(status int, title, detail string) *Error {
+	return &Error{
+		Status: statusToString(status),
+		Title:  title,
+		Detail: detail,
+	}
+}
+
+// WithPointer attaches a JSON Pointer (e.g. "/data/attributes/action")
+// identifying the offending field to an Error.
+func (e *Error) WithPointer(pointer string) *Error {
+	e.Source = &ErrorSource{Pointer: pointer}
+	return e
+}
+
+// Write encodes doc as the response body with status and the JSON:API
+// content type.
+func Write(w http.ResponseWriter, status int, doc *Document) {
+	w.Header().Set("Content-Type", MediaType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+// WriteResource wraps a single resource object (or slice of them) into a
+// Document and writes it.
+func WriteResource(w http.ResponseWriter, status int, data any) {
+	Write(w, status, &Document{Data: data})
+}
+
+// WriteError writes err as a single-element JSON:API errors document. If
+// err is not already an *Error, it is wrapped as an opaque 500.
+func WriteError(w http.ResponseWriter, err error) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		apiErr = NewError(http.StatusInternalServerError, "Internal Server Error", err.Error())
+	}
+	Write(w, apiErr.HTTPStatus(), &Document{Errors: []*Error{apiErr}})
+}
@@ -0,0 +1,79 @@
+package jsonapi
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ListParams holds the parsed JSON:API query parameters common to every
+// list endpoint.
+type ListParams struct {
+	// Include is the parsed, comma-separated "?include=" relationship
+	// path list, e.g. ["author", "author.company"].
+	Include []string
+
+	// Fields is the parsed "?fields[type]=a,b" sparse fieldset, keyed by
+	// resource type.
+	Fields map[string][]string
+
+	// Sort is the parsed "?sort=-created,name" list; a field prefixed
+	// with "-" sorts descending.
+	Sort []SortKey
+
+	// PageCursor is the raw "?page[cursor]=" value, opaque to this
+	// package; handlers pass it to their store's cursor-paginated query.
+	PageCursor string
+}
+
+// SortKey is one field from a parsed "?sort=" parameter.
+type SortKey struct {
+	Field      string
+	Descending bool
+}
+
+// ParseListParams extracts include/fields/sort/page[cursor] from an
+// inbound request's query string.
+func ParseListParams(values url.Values) ListParams {
+	params := ListParams{
+		Fields: make(map[string][]string),
+	}
+
+	if include := values.Get("include"); include != "" {
+		params.Include = splitCSV(include)
+	}
+
+	for key, vals := range values {
+		if !strings.HasPrefix(key, "fields[") || !strings.HasSuffix(key, "]") {
+			continue
+		}
+		resourceType := strings.TrimSuffix(strings.TrimPrefix(key, "fields["), "]")
+		if len(vals) > 0 {
+			params.Fields[resourceType] = splitCSV(vals[0])
+		}
+	}
+
+	if sort := values.Get("sort"); sort != "" {
+		for _, field := range splitCSV(sort) {
+			if strings.HasPrefix(field, "-") {
+				params.Sort = append(params.Sort, SortKey{Field: strings.TrimPrefix(field, "-"), Descending: true})
+			} else {
+				params.Sort = append(params.Sort, SortKey{Field: field})
+			}
+		}
+	}
+
+	params.PageCursor = values.Get("page[cursor]")
+
+	return params
+}
+
+func splitCSV(s string) []string {
+	parts := strings.Split(s, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
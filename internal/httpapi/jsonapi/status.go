@@ -0,0 +1,17 @@
+package jsonapi
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func statusToString(status int) string {
+	return strconv.Itoa(status)
+}
+
+func statusFromString(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("jsonapi: empty status")
+	}
+	return strconv.Atoi(s)
+}
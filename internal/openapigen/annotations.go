@@ -0,0 +1,189 @@
+package openapigen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// routeAnnotation is a parsed "@Route METHOD /path" line.
+type routeAnnotation struct {
+	Method string
+	Path   string
+}
+
+// paramAnnotation is a parsed "@Param name in type [required] ["desc"]"
+// line.
+type paramAnnotation struct {
+	Name        string
+	In          string // "path", "query", "header", or "body"
+	TypeName    string
+	Required    bool
+	Description string
+}
+
+// successAnnotation is a parsed "@Success status {object|string} Type
+// ["desc"]" line.
+type successAnnotation struct {
+	Status      int
+	Kind        string // "object" or "string"
+	TypeName    string
+	Description string
+}
+
+// handlerDoc is every annotation found in one handler method's doc
+// comment.
+type handlerDoc struct {
+	Route     routeAnnotation
+	Tag       string
+	Security  []string
+	Params    []paramAnnotation
+	Successes []successAnnotation
+}
+
+// parseHandlerDoc scans a doc comment's lines for "@"-prefixed
+// annotations. Lines that aren't recognized annotations are ordinary
+// prose and are ignored; a doc comment with no "@Route" line doesn't
+// describe an HTTP endpoint and is skipped by the caller.
+func parseHandlerDoc(comment string) (handlerDoc, error) {
+	var doc handlerDoc
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "@") {
+			continue
+		}
+		fields := splitAnnotation(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch fields[0] {
+		case "@Route":
+			if len(fields) != 3 {
+				return doc, fmt.Errorf("malformed @Route annotation: %q", line)
+			}
+			doc.Route = routeAnnotation{Method: fields[1], Path: fields[2]}
+		case "@Tag":
+			if len(fields) < 2 {
+				return doc, fmt.Errorf("malformed @Tag annotation: %q", line)
+			}
+			doc.Tag = fields[1]
+		case "@Security":
+			if len(fields) != 2 {
+				return doc, fmt.Errorf("malformed @Security annotation: %q", line)
+			}
+			doc.Security = append(doc.Security, fields[1])
+		case "@Param":
+			p, err := parseParamAnnotation(fields)
+			if err != nil {
+				return doc, err
+			}
+			doc.Params = append(doc.Params, p)
+		case "@Success":
+			s, err := parseSuccessAnnotation(fields)
+			if err != nil {
+				return doc, err
+			}
+			doc.Successes = append(doc.Successes, s)
+		}
+	}
+	return doc, nil
+}
+
+func parseParamAnnotation(fields []string) (paramAnnotation, error) {
+	if len(fields) < 4 {
+		return paramAnnotation{}, fmt.Errorf("malformed @Param annotation: %v", fields)
+	}
+	p := paramAnnotation{Name: fields[1], In: fields[2], TypeName: fields[3]}
+	rest := fields[4:]
+	if len(rest) > 0 && rest[0] == "required" {
+		p.Required = true
+		rest = rest[1:]
+	}
+	if len(rest) > 0 {
+		p.Description = strings.Trim(rest[0], `"`)
+	}
+	return p, nil
+}
+
+func parseSuccessAnnotation(fields []string) (successAnnotation, error) {
+	if len(fields) < 4 {
+		return successAnnotation{}, fmt.Errorf("malformed @Success annotation: %v", fields)
+	}
+	status, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return successAnnotation{}, fmt.Errorf("malformed @Success status %q: %w", fields[1], err)
+	}
+	s := successAnnotation{
+		Status:   status,
+		Kind:     strings.Trim(fields[2], "{}"),
+		TypeName: fields[3],
+	}
+	if len(fields) > 4 {
+		s.Description = strings.Trim(fields[4], `"`)
+	}
+	return s, nil
+}
+
+// packageDoc is every package-level annotation found in a package's doc
+// comment: "@Tag name description" and "@SecurityScheme name type
+// scheme".
+type packageDoc struct {
+	Tags            map[string]string
+	SecuritySchemes map[string]SecurityScheme
+}
+
+func parsePackageDoc(comment string) packageDoc {
+	doc := packageDoc{Tags: map[string]string{}, SecuritySchemes: map[string]SecurityScheme{}}
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		fields := splitAnnotation(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "@Tag":
+			if len(fields) >= 2 {
+				doc.Tags[fields[1]] = strings.Join(fields[2:], " ")
+			}
+		case "@SecurityScheme":
+			if len(fields) >= 3 {
+				scheme := SecurityScheme{Type: fields[2]}
+				if len(fields) >= 4 {
+					scheme.Scheme = fields[3]
+				}
+				doc.SecuritySchemes[fields[1]] = scheme
+			}
+		}
+	}
+	return doc
+}
+
+// splitAnnotation tokenizes an annotation line, keeping double-quoted
+// substrings (descriptions) intact as a single field.
+func splitAnnotation(line string) []string {
+	if !strings.HasPrefix(line, "@") {
+		return nil
+	}
+	var fields []string
+	var cur strings.Builder
+	inQuotes := false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			cur.WriteRune(r)
+		case r == ' ' && !inQuotes:
+			if cur.Len() > 0 {
+				fields = append(fields, cur.String())
+				cur.Reset()
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if cur.Len() > 0 {
+		fields = append(fields, cur.String())
+	}
+	return fields
+}
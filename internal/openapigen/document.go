@@ -0,0 +1,94 @@
+// Package openapigen generates an OpenAPI 3 document from "@Route" /
+// "@Param" / "@Success" / "@Security" / "@Tag" annotations placed in Go
+// doc comments above HTTP handler methods and above the package clause
+// that groups them. See cmd/gen-openapi for the build-time tool that
+// drives this package.
+package openapigen
+
+// Document is the subset of the OpenAPI 3.0 object model this generator
+// produces.
+type Document struct {
+	OpenAPI    string              `yaml:"openapi"`
+	Info       Info                `yaml:"info"`
+	Tags       []Tag               `yaml:"tags,omitempty"`
+	Paths      map[string]PathItem `yaml:"paths"`
+	Components Components          `yaml:"components"`
+}
+
+// Tag is an OpenAPI top-level tag object, built from a package's "@Tag
+// name description" annotation.
+type Tag struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// Info is the OpenAPI "info" object.
+type Info struct {
+	Title   string `yaml:"title"`
+	Version string `yaml:"version"`
+}
+
+// PathItem groups the operations defined for one path, keyed by lowercase
+// HTTP method ("get", "post", ...).
+type PathItem map[string]Operation
+
+// Operation is a single OpenAPI operation (one HTTP method on one path).
+type Operation struct {
+	Tags        []string              `yaml:"tags,omitempty"`
+	Summary     string                `yaml:"summary,omitempty"`
+	Parameters  []Parameter           `yaml:"parameters,omitempty"`
+	RequestBody *RequestBody          `yaml:"requestBody,omitempty"`
+	Responses   map[string]Response   `yaml:"responses"`
+	Security    []map[string][]string `yaml:"security,omitempty"`
+}
+
+// Parameter is an OpenAPI parameter object ("path", "query", or
+// "header").
+type Parameter struct {
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Required    bool    `yaml:"required,omitempty"`
+	Description string  `yaml:"description,omitempty"`
+	Schema      *Schema `yaml:"schema,omitempty"`
+}
+
+// RequestBody is an OpenAPI request body object.
+type RequestBody struct {
+	Required bool                 `yaml:"required,omitempty"`
+	Content  map[string]MediaType `yaml:"content"`
+}
+
+// Response is an OpenAPI response object.
+type Response struct {
+	Description string               `yaml:"description"`
+	Content     map[string]MediaType `yaml:"content,omitempty"`
+}
+
+// MediaType is an OpenAPI media type object, e.g. the value under
+// "content.application/json".
+type MediaType struct {
+	Schema *Schema `yaml:"schema"`
+}
+
+// Schema is the subset of the OpenAPI/JSON Schema object model this
+// generator emits: either a reference to a named component schema, a
+// primitive type, or an array of either.
+type Schema struct {
+	Ref        string             `yaml:"$ref,omitempty"`
+	Type       string             `yaml:"type,omitempty"`
+	Format     string             `yaml:"format,omitempty"`
+	Items      *Schema            `yaml:"items,omitempty"`
+	Properties map[string]*Schema `yaml:"properties,omitempty"`
+}
+
+// Components holds reusable schema and security scheme definitions.
+type Components struct {
+	Schemas         map[string]*Schema        `yaml:"schemas,omitempty"`
+	SecuritySchemes map[string]SecurityScheme `yaml:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is an OpenAPI security scheme object.
+type SecurityScheme struct {
+	Type   string `yaml:"type"`
+	Scheme string `yaml:"scheme,omitempty"`
+}
@@ -0,0 +1,279 @@
+package openapigen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const loadMode = packages.NeedName |
+	packages.NeedTypes |
+	packages.NeedTypesInfo |
+	packages.NeedSyntax |
+	packages.NeedDeps
+
+// Config controls Generate's output.
+type Config struct {
+	Title   string
+	Version string
+}
+
+// Generate walks every method in the given packages, collects handler
+// doc-comment annotations, and produces an OpenAPI Document. A method's
+// doc comment must contain "@Route METHOD /path" to be treated as an
+// endpoint; other methods are ignored.
+func Generate(patterns []string, cfg Config) (*Document, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: loadMode}, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("openapigen: load packages: %w", err)
+	}
+
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: cfg.Title, Version: cfg.Version},
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas:         map[string]*Schema{},
+			SecuritySchemes: map[string]SecurityScheme{},
+		},
+	}
+
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			return nil, fmt.Errorf("openapigen: package %s has errors: %v", pkg.PkgPath, pkg.Errors[0])
+		}
+		if err := processPackage(pkg, doc); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+func addTag(doc *Document, name, description string) {
+	for i := range doc.Tags {
+		if doc.Tags[i].Name == name {
+			return
+		}
+	}
+	doc.Tags = append(doc.Tags, Tag{Name: name, Description: description})
+}
+
+func processPackage(pkg *packages.Package, doc *Document) error {
+	for _, file := range pkg.Syntax {
+		if file.Doc != nil {
+			pdoc := parsePackageDoc(file.Doc.Text())
+			for name, desc := range pdoc.Tags {
+				addTag(doc, name, desc)
+			}
+			for name, scheme := range pdoc.SecuritySchemes {
+				doc.Components.SecuritySchemes[name] = scheme
+			}
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Doc == nil {
+				continue
+			}
+			hdoc, err := parseHandlerDoc(fn.Doc.Text())
+			if err != nil {
+				return fmt.Errorf("openapigen: %s.%s: %w", pkg.PkgPath, fn.Name.Name, err)
+			}
+			if hdoc.Route.Method == "" {
+				continue
+			}
+			if err := addOperation(pkg, doc, hdoc); err != nil {
+				return fmt.Errorf("openapigen: %s.%s: %w", pkg.PkgPath, fn.Name.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func addOperation(pkg *packages.Package, doc *Document, hdoc handlerDoc) error {
+	op := Operation{
+		Responses: map[string]Response{},
+	}
+	if hdoc.Tag != "" {
+		op.Tags = []string{hdoc.Tag}
+	}
+	for _, scheme := range hdoc.Security {
+		op.Security = append(op.Security, map[string][]string{scheme: {}})
+	}
+
+	for _, p := range hdoc.Params {
+		if p.In == "body" {
+			schema, err := resolveSchema(pkg, doc, p.TypeName)
+			if err != nil {
+				return err
+			}
+			op.RequestBody = &RequestBody{
+				Required: p.Required,
+				Content: map[string]MediaType{
+					"application/json": {Schema: schema},
+				},
+			}
+			continue
+		}
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:        p.Name,
+			In:          p.In,
+			Required:    p.Required || p.In == "path",
+			Description: p.Description,
+			Schema:      primitiveSchema(p.TypeName),
+		})
+	}
+
+	for _, s := range hdoc.Successes {
+		resp := Response{Description: s.Description}
+		if resp.Description == "" {
+			resp.Description = "OK"
+		}
+		if s.Kind == "object" {
+			schema, err := resolveSchema(pkg, doc, s.TypeName)
+			if err != nil {
+				return err
+			}
+			resp.Content = map[string]MediaType{
+				"application/json": {Schema: schema},
+			}
+		}
+		op.Responses[fmt.Sprintf("%d", s.Status)] = resp
+	}
+
+	path := convertPathParams(hdoc.Route.Path)
+	item, ok := doc.Paths[path]
+	if !ok {
+		item = PathItem{}
+	}
+	item[strings.ToLower(hdoc.Route.Method)] = op
+	doc.Paths[path] = item
+	return nil
+}
+
+// convertPathParams rewrites the Go 1.22 mux "{name}" path-parameter
+// syntax used by @Route (which is how the handlers themselves declare
+// routes) into itself — both net/http and OpenAPI use "{name}", so this
+// is an identity mapping kept as a named step in case a future mux
+// syntax diverges from OpenAPI's.
+func convertPathParams(path string) string {
+	return path
+}
+
+func primitiveSchema(typeName string) *Schema {
+	switch typeName {
+	case "int", "int32", "int64":
+		return &Schema{Type: "integer"}
+	case "bool":
+		return &Schema{Type: "boolean"}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+// resolveSchema resolves typeName to a named Go type in pkg and emits it
+// as a component schema, returning a $ref to it. Struct types its fields
+// reference are inlined, except named ones already emitted as components
+// (including typeName itself), which are referenced by $ref.
+func resolveSchema(pkg *packages.Package, doc *Document, typeName string) (*Schema, error) {
+	if _, ok := doc.Components.Schemas[typeName]; ok {
+		return &Schema{Ref: "#/components/schemas/" + typeName}, nil
+	}
+
+	obj := pkg.Types.Scope().Lookup(typeName)
+	if obj == nil {
+		return nil, fmt.Errorf("type %q not found in package %s", typeName, pkg.PkgPath)
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("type %q in package %s is not a named type", typeName, pkg.PkgPath)
+	}
+	structType, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("type %q in package %s is not a struct", typeName, pkg.PkgPath)
+	}
+
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	// Reserve the slot before recursing so a self-referential struct
+	// doesn't loop forever resolving itself.
+	doc.Components.Schemas[typeName] = schema
+
+	for i := 0; i < structType.NumFields(); i++ {
+		field := structType.Field(i)
+		if !field.Exported() {
+			continue
+		}
+		jsonName, omit := jsonFieldName(structType.Tag(i), field.Name())
+		if omit {
+			continue
+		}
+		schema.Properties[jsonName] = schemaForType(doc, field.Type())
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + typeName}, nil
+}
+
+func schemaForType(doc *Document, t types.Type) *Schema {
+	if named, ok := t.(*types.Named); ok {
+		if _, ok := doc.Components.Schemas[named.Obj().Name()]; ok {
+			return &Schema{Ref: "#/components/schemas/" + named.Obj().Name()}
+		}
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return &Schema{Type: "boolean"}
+		case u.Info()&types.IsInteger != 0:
+			return &Schema{Type: "integer"}
+		case u.Info()&types.IsFloat != 0:
+			return &Schema{Type: "number"}
+		default:
+			return &Schema{Type: "string"}
+		}
+	case *types.Slice:
+		return &Schema{Type: "array", Items: schemaForType(doc, u.Elem())}
+	case *types.Array:
+		return &Schema{Type: "array", Items: schemaForType(doc, u.Elem())}
+	case *types.Map:
+		return &Schema{Type: "object"}
+	case *types.Pointer:
+		return schemaForType(doc, u.Elem())
+	case *types.Struct:
+		props := map[string]*Schema{}
+		for i := 0; i < u.NumFields(); i++ {
+			field := u.Field(i)
+			if !field.Exported() {
+				continue
+			}
+			name, omit := jsonFieldName(u.Tag(i), field.Name())
+			if omit {
+				continue
+			}
+			props[name] = schemaForType(doc, field.Type())
+		}
+		return &Schema{Type: "object", Properties: props}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+func jsonFieldName(tag, fieldName string) (name string, omit bool) {
+	t := reflect.StructTag(tag).Get("json")
+	if t == "" {
+		return fieldName, false
+	}
+	parts := strings.Split(t, ",")
+	if parts[0] == "-" {
+		return "", true
+	}
+	if parts[0] == "" {
+		return fieldName, false
+	}
+	return parts[0], false
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// oauth2Provider is a generic Provider implementation driven entirely by a
+// ProviderConfig, sufficient for Google, GitHub, GitLab and any OIDC-
+// compliant issuer. Providers that need non-standard behaviour (e.g. a
+// userinfo endpoint with a different shape) can wrap it.
+type oauth2Provider struct {
+	cfg        ProviderConfig
+	httpClient *http.Client
+
+	// fetchIdentity calls the provider's userinfo endpoint and normalizes
+	// the response. It is provider-specific because the claim names for
+	// subject/email/name are not fully standardized outside OIDC.
+	fetchIdentity func(ctx context.Context, client *http.Client, accessToken string) (Identity, error)
+}
+
+// NewOAuth2Provider constructs a Provider from configuration, using
+// fetchIdentity to turn a userinfo response into an Identity.
+func NewOAuth2Provider(cfg ProviderConfig, fetchIdentity func(ctx context.Context, client *http.Client, accessToken string) (Identity, error)) Provider {
+	return &oauth2Provider{
+		cfg:           cfg,
+		httpClient:    http.DefaultClient,
+		fetchIdentity: fetchIdentity,
+	}
+}
+
+func (p *oauth2Provider) Name() string { return p.cfg.Name }
+
+func (p *oauth2Provider) LoginURL(state string) string {
+	v := url.Values{
+		"client_id":     {p.cfg.ClientID},
+		"redirect_uri":  {p.cfg.RedirectURL},
+		"response_type": {"code"},
+		"scope":         {strings.Join(p.cfg.Scopes, " ")},
+		"state":         {state},
+	}
+	if p.cfg.PKCE {
+		if challenge, ok := pkceChallengeFromState(state); ok {
+			v.Set("code_challenge", challenge)
+			v.Set("code_challenge_method", "S256")
+		}
+	}
+	sep := "?"
+	if strings.Contains(p.cfg.AuthURL, "?") {
+		sep = "&"
+	}
+	return p.cfg.AuthURL + sep + v.Encode()
+}
+
+func (p *oauth2Provider) Exchange(ctx context.Context, code, codeVerifier string) (Identity, Token, error) {
+	form := url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {p.cfg.RedirectURL},
+		"client_id":    {p.cfg.ClientID},
+	}
+	if p.cfg.ClientSecret != "" {
+		form.Set("client_secret", p.cfg.ClientSecret)
+	}
+	if p.cfg.PKCE {
+		form.Set("code_verifier", codeVerifier)
+	}
+
+	tok, err := p.requestToken(ctx, form)
+	if err != nil {
+		return Identity{}, Token{}, err
+	}
+
+	identity, err := p.fetchIdentity(ctx, p.httpClient, tok.AccessToken)
+	if err != nil {
+		return Identity{}, Token{}, fmt.Errorf("auth: %s: fetch identity: %w", p.cfg.Name, err)
+	}
+	return identity, tok, nil
+}
+
+func (p *oauth2Provider) Refresh(ctx context.Context, refreshToken string) (Token, error) {
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {p.cfg.ClientID},
+	}
+	if p.cfg.ClientSecret != "" {
+		form.Set("client_secret", p.cfg.ClientSecret)
+	}
+	return p.requestToken(ctx, form)
+}
+
+func (p *oauth2Provider) requestToken(ctx context.Context, form url.Values) (Token, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return Token{}, fmt.Errorf("auth: %s: token request: %w", p.cfg.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Token{}, fmt.Errorf("auth: %s: token endpoint returned %s", p.cfg.Name, resp.Status)
+	}
+
+	var body struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		TokenType    string `json:"token_type"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return Token{}, fmt.Errorf("auth: %s: decode token response: %w", p.cfg.Name, err)
+	}
+	return Token{AccessToken: body.AccessToken, RefreshToken: body.RefreshToken, TokenType: body.TokenType}, nil
+}
+
+// newNonce returns a URL-safe random nonce of n bytes, used for state and
+// PKCE code verifiers.
+func newNonce(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
@@ -0,0 +1,55 @@
+package auth
+
+import "context"
+
+// Identity is the normalized profile an external Provider returns after a
+// successful code exchange.
+type Identity struct {
+	// Subject is the provider's stable identifier for the user.
+	Subject string
+	Email   string
+	Name    string
+}
+
+// Token is an external provider's access/refresh token pair.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	TokenType    string
+}
+
+// Provider is implemented by each external OAuth2/OIDC login method
+// (Google, GitHub, GitLab, generic OIDC, ...).
+type Provider interface {
+	// Name identifies the provider in routes and configuration, e.g. "google".
+	Name() string
+
+	// LoginURL returns the authorization endpoint URL a client should be
+	// redirected to, with state embedded as the OAuth2 "state" parameter.
+	LoginURL(state string) string
+
+	// Exchange trades an authorization code for an external Identity and
+	// the token pair issued alongside it. codeVerifier is the PKCE code
+	// verifier from the login's state and is ignored by providers
+	// constructed with PKCE disabled.
+	Exchange(ctx context.Context, code, codeVerifier string) (Identity, Token, error)
+
+	// Refresh exchanges a refresh token for a new Token.
+	Refresh(ctx context.Context, refreshToken string) (Token, error)
+}
+
+// ProviderConfig describes how to construct a Provider from the config
+// layer. ClientSecret is optional for public clients using PKCE.
+type ProviderConfig struct {
+	Name         string
+	ClientID     string
+	ClientSecret string
+	AuthURL      string
+	TokenURL     string
+	RedirectURL  string
+	Scopes       []string
+
+	// PKCE enables the code_verifier/code_challenge exchange for public
+	// clients that cannot hold a client secret.
+	PKCE bool
+}
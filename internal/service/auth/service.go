@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-sso-example/auth-service/internal/connector"
+	"github.com/go-sso-example/auth-service/internal/service/user"
+)
+
+// ErrUnknownProvider is returned when a request names a provider that was
+// not registered with the Service.
+var ErrUnknownProvider = errors.New("auth: unknown provider")
+
+// ErrUnknownConnector is returned when a request names an identity
+// connector that was not registered with the Service.
+var ErrUnknownConnector = errors.New("auth: unknown connector")
+
+// ErrProvisioningDisabled is returned on a first-time external login when
+// AutoProvision is false and no local user is already linked.
+var ErrProvisioningDisabled = errors.New("auth: no local account linked and auto-provisioning is disabled")
+
+// TokenIssuer mints the session JWT handed back to clients, shared by the
+// local password login path and every external Provider.
+type TokenIssuer interface {
+	Issue(ctx context.Context, u *user.User) (string, error)
+}
+
+// Config controls Service-wide external auth behaviour.
+type Config struct {
+	// AutoProvision creates a local user on first login from a provider
+	// that has no existing linked account, instead of rejecting it.
+	AutoProvision bool
+
+	// StateKey signs the OAuth2 state parameter. It must be stable across
+	// instances of the service that may handle the callback.
+	StateKey []byte
+}
+
+// Service implements local password authentication, pluggable external
+// OAuth2/OIDC provider login, and federated login through any
+// registered connector.IdentityConnector (e.g. LDAP), issuing the same
+// session token regardless of which path a user authenticated through.
+type Service struct {
+	users      *user.Service
+	issuer     TokenIssuer
+	providers  map[string]Provider
+	connectors map[string]connector.IdentityConnector
+	cfg        Config
+}
+
+// NewService constructs an auth Service. providers may be empty; external
+// login routes simply 404 in that case. identityConnectors is typically
+// built by the caller from connector.Load and type-asserting the
+// connector.IdentityConnector-capable entries (see
+// internal/connector's package doc).
+func NewService(users *user.Service, issuer TokenIssuer, cfg Config, identityConnectors map[string]connector.IdentityConnector, providers ...Provider) *Service {
+	m := make(map[string]Provider, len(providers))
+	for _, p := range providers {
+		m[p.Name()] = p
+	}
+	return &Service{users: users, issuer: issuer, providers: m, connectors: identityConnectors, cfg: cfg}
+}
+
+// Provider returns the named external provider, or false if it is not
+// registered.
+func (s *Service) Provider(name string) (Provider, bool) {
+	p, ok := s.providers[name]
+	return p, ok
+}
+
+// BeginLogin starts an external login: it mints a signed, nonce-protected
+// state (always carrying a PKCE code verifier, which only providers
+// constructed with PKCE enabled put to use) and
+// returns the URL to redirect the user-agent to plus the state value the
+// caller must stash in a short-lived cookie to verify on callback.
+func (s *Service) BeginLogin(ctx context.Context, providerName string) (redirectURL, state string, err error) {
+	p, ok := s.providers[providerName]
+	if !ok {
+		return "", "", ErrUnknownProvider
+	}
+
+	nonce, err := newNonce(16)
+	if err != nil {
+		return "", "", fmt.Errorf("auth: generate nonce: %w", err)
+	}
+	verifier, err := newNonce(32)
+	if err != nil {
+		return "", "", fmt.Errorf("auth: generate pkce verifier: %w", err)
+	}
+
+	signed := signState(s.cfg.StateKey, loginState{
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		IssuedAt:     time.Now(),
+	})
+	return p.LoginURL(signed), signed, nil
+}
+
+// CompleteLogin finishes an external login from a provider callback. state
+// is the value reported by the provider, cookieState is the value the
+// caller previously stashed via BeginLogin; they must match exactly so a
+// state forged or replayed from a different login attempt is rejected.
+func (s *Service) CompleteLogin(ctx context.Context, providerName, code, state, cookieState string) (token string, identity Identity, err error) {
+	if state == "" || state != cookieState {
+		return "", Identity{}, fmt.Errorf("auth: state mismatch")
+	}
+
+	ls, err := verifyState(s.cfg.StateKey, state)
+	if err != nil {
+		return "", Identity{}, err
+	}
+	if ls.Provider != providerName {
+		return "", Identity{}, fmt.Errorf("auth: state issued for provider %q, callback is for %q", ls.Provider, providerName)
+	}
+
+	p, ok := s.providers[providerName]
+	if !ok {
+		return "", Identity{}, ErrUnknownProvider
+	}
+
+	identity, _, err = p.Exchange(ctx, code, ls.CodeVerifier)
+	if err != nil {
+		return "", Identity{}, fmt.Errorf("auth: exchange code: %w", err)
+	}
+
+	token, err = s.resolveAndIssue(ctx, providerName, identity)
+	if err != nil {
+		return "", Identity{}, err
+	}
+	return token, identity, nil
+}
+
+// AuthenticateWithConnector authenticates creds against the named
+// connector.IdentityConnector (e.g. an LDAP bind) and, on success, resolves or provisions the matching local user and
+// issues the same session token every other login path issues. The
+// connector to use is named explicitly by the caller (a login request's
+// connector_id) rather than tried in order, since two connectors could
+// otherwise disagree about the same username.
+func (s *Service) AuthenticateWithConnector(ctx context.Context, connectorID string, creds connector.Credentials) (token string, err error) {
+	c, ok := s.connectors[connectorID]
+	if !ok {
+		return "", ErrUnknownConnector
+	}
+
+	identity, err := c.Authenticate(ctx, creds)
+	if err != nil {
+		return "", fmt.Errorf("auth: connector %q: %w", connectorID, err)
+	}
+
+	return s.resolveAndIssue(ctx, connectorID, Identity{
+		Subject: identity.Subject,
+		Email:   identity.Email,
+		Name:    identity.Name,
+	})
+}
+
+// resolveAndIssue resolves identity (reported by externalID, a provider
+// or connector name) to a local user — auto-provisioning one on first
+// login if configured to — and issues that user a session token.
+func (s *Service) resolveAndIssue(ctx context.Context, externalID string, identity Identity) (string, error) {
+	u, err := s.users.GetByExternalIdentity(ctx, externalID, identity.Subject)
+	if errors.Is(err, user.ErrNotFound) {
+		if !s.cfg.AutoProvision {
+			return "", ErrProvisioningDisabled
+		}
+		u, err = s.users.CreateFromExternalIdentity(ctx, externalID, identity.Subject, identity.Email)
+	}
+	if err != nil {
+		return "", fmt.Errorf("auth: resolve local user: %w", err)
+	}
+
+	token, err := s.issuer.Issue(ctx, u)
+	if err != nil {
+		return "", fmt.Errorf("auth: issue token: %w", err)
+	}
+	return token, nil
+}
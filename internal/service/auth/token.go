@@ -0,0 +1,12 @@
+package auth
+
+import "context"
+
+// TokenVerifier validates a bearer token previously issued by a
+// TokenIssuer and returns the subject (user id) it was issued for.
+// Implemented alongside TokenIssuer by whatever issues the session JWT,
+// and shared by the HTTP mux and the gRPC auth interceptor so both
+// transports authenticate requests the same way.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (subject string, err error)
+}
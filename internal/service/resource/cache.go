@@ -0,0 +1,115 @@
+package resource
+
+import (
+	"container/list"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// decisionCache is a fixed-size LRU cache of Decisions keyed on the
+// (subject, service, resource, action) tuple plus every condition-
+// relevant attribute (EvalContext), invalidated wholesale on any policy
+// write since a single write can change the outcome for an unbounded
+// number of cached keys.
+type decisionCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type cacheEntry struct {
+	key      string
+	decision Decision
+}
+
+func newDecisionCache(capacity int) *decisionCache {
+	return &decisionCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// decisionCacheKey includes attrs so that a policy gated on e.g.
+// cidr(request.ip, ...) or hour_between(...) never has a decision made
+// for one request served back to a different request whose attributes
+// would evaluate that condition differently.
+func decisionCacheKey(subject, service, resourcePath, action string, attrs EvalContext) string {
+	return strings.Join([]string{
+		subject, service, resourcePath, action,
+		canonicalizeAttrs(attrs.Subject),
+		canonicalizeAttrs(attrs.Resource),
+		canonicalizeAttrs(attrs.Request),
+	}, "\x1f")
+}
+
+// canonicalizeAttrs renders m as a stable string regardless of map
+// iteration order, so equal attribute sets always produce equal keys.
+func canonicalizeAttrs(m map[string]string) string {
+	if len(m) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(m[k])
+	}
+	return b.String()
+}
+
+func (c *decisionCache) get(key string) (Decision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return Decision{}, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*cacheEntry).decision, true
+}
+
+func (c *decisionCache) put(key string, d Decision) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*cacheEntry).decision = d
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&cacheEntry{key: key, decision: d})
+	c.entries[key] = el
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*cacheEntry).key)
+		}
+	}
+}
+
+// invalidateAll drops every cached decision. Called after any policy
+// write since policies don't carry enough structure to cheaply identify
+// which cached keys they affect.
+func (c *decisionCache) invalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*list.Element)
+	c.order = list.New()
+}
@@ -0,0 +1,86 @@
+package resource
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-sso-example/auth-service/internal/connector"
+)
+
+// connectorStoreAdapter satisfies PolicyStore by delegating to a
+// connector.ResourceStore loaded via the connector registry, translating
+// between connector.Policy and this package's Policy.
+type connectorStoreAdapter struct {
+	store connector.ResourceStore
+}
+
+// NewStoreFromConnector wraps a connector.ResourceStore as a PolicyStore
+// so it can back NewService the same way any hand-written PolicyStore
+// would.
+func NewStoreFromConnector(store connector.ResourceStore) PolicyStore {
+	return &connectorStoreAdapter{store: store}
+}
+
+func (a *connectorStoreAdapter) Get(ctx context.Context, id string) (*Policy, error) {
+	p, err := a.store.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, connector.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return fromConnectorPolicy(p), nil
+}
+
+func (a *connectorStoreAdapter) List(ctx context.Context, service string) ([]*Policy, error) {
+	policies, err := a.store.List(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*Policy, len(policies))
+	for i, p := range policies {
+		out[i] = fromConnectorPolicy(p)
+	}
+	return out, nil
+}
+
+func (a *connectorStoreAdapter) Create(ctx context.Context, p *Policy) error {
+	cp := toConnectorPolicy(p)
+	if err := a.store.Create(ctx, cp); err != nil {
+		return err
+	}
+	p.ID = cp.ID
+	return nil
+}
+
+func (a *connectorStoreAdapter) Update(ctx context.Context, p *Policy) error {
+	return a.store.Update(ctx, toConnectorPolicy(p))
+}
+
+func (a *connectorStoreAdapter) Delete(ctx context.Context, id string) error {
+	return a.store.Delete(ctx, id)
+}
+
+func fromConnectorPolicy(p *connector.Policy) *Policy {
+	return &Policy{
+		ID:         p.ID,
+		Subject:    p.Subject,
+		Service:    p.Service,
+		Resource:   p.Resource,
+		Action:     p.Action,
+		Effect:     Effect(p.Effect),
+		Conditions: p.Conditions,
+	}
+}
+
+func toConnectorPolicy(p *Policy) *connector.Policy {
+	return &connector.Policy{
+		ID:         p.ID,
+		Subject:    p.Subject,
+		Service:    p.Service,
+		Resource:   p.Resource,
+		Action:     p.Action,
+		Effect:     string(p.Effect),
+		Conditions: p.Conditions,
+	}
+}
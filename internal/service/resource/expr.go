@@ -0,0 +1,240 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errMissingAttribute is wrapped by lookup when a condition references an
+// attribute the EvalContext doesn't carry. Check treats it as the policy
+// not matching rather than an evaluation failure, since a request simply
+// not carrying an optional attribute (e.g. no request.ip on an internal
+// call) is an expected shape, not a malformed condition.
+var errMissingAttribute = errors.New("expr: missing attribute")
+
+// EvalContext supplies the attribute values a condition expression can
+// reference, namespaced as subject.*, resource.*, request.*.
+type EvalContext struct {
+	Subject  map[string]string
+	Resource map[string]string
+	Request  map[string]string
+}
+
+func (c EvalContext) lookup(path string) (string, error) {
+	ns, field, ok := strings.Cut(path, ".")
+	if !ok {
+		return "", fmt.Errorf("expr: %q is not a namespaced attribute", path)
+	}
+	var m map[string]string
+	switch ns {
+	case "subject":
+		m = c.Subject
+	case "resource":
+		m = c.Resource
+	case "request":
+		m = c.Request
+	default:
+		return "", fmt.Errorf("expr: unknown namespace %q", ns)
+	}
+	v, ok := m[field]
+	if !ok {
+		return "", fmt.Errorf("%w: %s has no attribute %q", errMissingAttribute, ns, field)
+	}
+	return v, nil
+}
+
+// evalCondition evaluates a single condition expression against ctx. The
+// grammar is deliberately small:
+//
+//	expr := clause (("&&" | "||") clause)*
+//	clause := attrPath "==" literal
+//	        | attrPath "!=" literal
+//	        | "cidr(" attrPath "," literal ")"
+//	        | "hour_between(" literal "," literal ")"
+//
+// attrPath is "subject.id", "resource.owner", "request.ip", etc. literal
+// is either a bare word/number or a "quoted string". The expression is
+// split on top-level "&&" first, so "||" binds tighter than "&&":
+// `a && b || c` evaluates as a && (b || c). There are no parentheses for
+// grouping, which is sufficient for the attribute checks policies need.
+func evalCondition(expr string, ctx EvalContext) (bool, error) {
+	expr = strings.TrimSpace(expr)
+	if and := splitTopLevel(expr, "&&"); len(and) > 1 {
+		for _, clause := range and {
+			ok, err := evalCondition(clause, ctx)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	if or := splitTopLevel(expr, "||"); len(or) > 1 {
+		for _, clause := range or {
+			ok, err := evalCondition(clause, ctx)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	switch {
+	case strings.HasPrefix(expr, "cidr("):
+		return evalCIDR(expr, ctx)
+	case strings.HasPrefix(expr, "hour_between("):
+		return evalHourBetween(expr, ctx)
+	case strings.Contains(expr, "!="):
+		lhs, rhs, _ := strings.Cut(expr, "!=")
+		a, b, err := resolvePair(lhs, rhs, ctx)
+		if err != nil {
+			return false, err
+		}
+		return a != b, nil
+	case strings.Contains(expr, "=="):
+		lhs, rhs, _ := strings.Cut(expr, "==")
+		a, b, err := resolvePair(lhs, rhs, ctx)
+		if err != nil {
+			return false, err
+		}
+		return a == b, nil
+	}
+	return false, fmt.Errorf("expr: cannot parse condition %q", expr)
+}
+
+func resolvePair(lhs, rhs string, ctx EvalContext) (string, string, error) {
+	a, err := resolveOperand(lhs, ctx)
+	if err != nil {
+		return "", "", err
+	}
+	b, err := resolveOperand(rhs, ctx)
+	if err != nil {
+		return "", "", err
+	}
+	return a, b, nil
+}
+
+func resolveOperand(raw string, ctx EvalContext) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, `"`) && strings.HasSuffix(raw, `"`) && len(raw) >= 2 {
+		return strings.Trim(raw, `"`), nil
+	}
+	if strings.Contains(raw, ".") && !isNumber(raw) {
+		return ctx.lookup(raw)
+	}
+	return raw, nil
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil
+}
+
+func evalCIDR(expr string, ctx EvalContext) (bool, error) {
+	args, err := callArgs(expr, "cidr")
+	if err != nil || len(args) != 2 {
+		return false, fmt.Errorf("expr: cidr() takes 2 arguments")
+	}
+	ipStr, err := resolveOperand(args[0], ctx)
+	if err != nil {
+		return false, err
+	}
+	cidr, err := resolveOperand(args[1], ctx)
+	if err != nil {
+		return false, err
+	}
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return false, fmt.Errorf("expr: invalid ip %q", ipStr)
+	}
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false, fmt.Errorf("expr: invalid cidr %q: %w", cidr, err)
+	}
+	return network.Contains(ip), nil
+}
+
+func evalHourBetween(expr string, ctx EvalContext) (bool, error) {
+	args, err := callArgs(expr, "hour_between")
+	if err != nil || len(args) != 2 {
+		return false, fmt.Errorf("expr: hour_between() takes 2 arguments")
+	}
+	start, err := strconv.Atoi(strings.TrimSpace(args[0]))
+	if err != nil {
+		return false, fmt.Errorf("expr: invalid start hour %q", args[0])
+	}
+	end, err := strconv.Atoi(strings.TrimSpace(args[1]))
+	if err != nil {
+		return false, fmt.Errorf("expr: invalid end hour %q", args[1])
+	}
+
+	now, ok := ctx.Request["time"]
+	var hour int
+	if ok && now != "" {
+		t, err := time.Parse(time.RFC3339, now)
+		if err != nil {
+			return false, fmt.Errorf("expr: invalid request.time %q: %w", now, err)
+		}
+		hour = t.UTC().Hour()
+	} else {
+		hour = time.Now().UTC().Hour()
+	}
+
+	if start <= end {
+		return hour >= start && hour < end, nil
+	}
+	// Wrapping window, e.g. hour_between(22, 6).
+	return hour >= start || hour < end, nil
+}
+
+func callArgs(expr, fn string) ([]string, error) {
+	prefix := fn + "("
+	if !strings.HasPrefix(expr, prefix) || !strings.HasSuffix(expr, ")") {
+		return nil, fmt.Errorf("expr: malformed call %q", expr)
+	}
+	inner := expr[len(prefix) : len(expr)-1]
+	return splitTopLevel(inner, ","), nil
+}
+
+// splitTopLevel splits s on sep, ignoring occurrences inside double
+// quotes or nested parens, so "cidr(request.ip, \"10.0.0.0/8\")" isn't
+// torn apart on the comma inside the call.
+func splitTopLevel(s, sep string) []string {
+	var parts []string
+	depth := 0
+	inQuotes := false
+	last := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case '(':
+			if !inQuotes {
+				depth++
+			}
+		case ')':
+			if !inQuotes {
+				depth--
+			}
+		}
+		if !inQuotes && depth == 0 && i+len(sep) <= len(s) && s[i:i+len(sep)] == sep {
+			parts = append(parts, s[last:i])
+			last = i + len(sep)
+			i += len(sep) - 1
+		}
+	}
+	parts = append(parts, s[last:])
+	if len(parts) == 1 {
+		return parts
+	}
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+	}
+	return parts
+}
@@ -0,0 +1,99 @@
+package resource
+
+import "testing"
+
+func TestEvalConditionEquality(t *testing.T) {
+	ctx := EvalContext{Resource: map[string]string{"owner": "alice"}, Subject: map[string]string{"id": "alice"}}
+
+	ok, err := evalCondition("resource.owner == subject.id", ctx)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected resource.owner == subject.id to be true")
+	}
+
+	ok, err = evalCondition(`resource.owner != "bob"`, ctx)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if !ok {
+		t.Fatal(`expected resource.owner != "bob" to be true`)
+	}
+}
+
+func TestEvalConditionAndOr(t *testing.T) {
+	ctx := EvalContext{Subject: map[string]string{"id": "alice"}, Resource: map[string]string{"owner": "bob"}}
+
+	ok, err := evalCondition(`subject.id == "alice" && resource.owner == "alice"`, ctx)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if ok {
+		t.Fatal("expected && clause to be false when the second operand fails")
+	}
+
+	ok, err = evalCondition(`subject.id == "alice" || resource.owner == "alice"`, ctx)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected || clause to be true when the first operand succeeds")
+	}
+}
+
+func TestEvalConditionCIDR(t *testing.T) {
+	inRange := EvalContext{Request: map[string]string{"ip": "10.1.2.3"}}
+	ok, err := evalCondition(`cidr(request.ip, "10.0.0.0/8")`, inRange)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected 10.1.2.3 to be within 10.0.0.0/8")
+	}
+
+	outOfRange := EvalContext{Request: map[string]string{"ip": "192.168.1.1"}}
+	ok, err = evalCondition(`cidr(request.ip, "10.0.0.0/8")`, outOfRange)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if ok {
+		t.Fatal("expected 192.168.1.1 to be outside 10.0.0.0/8")
+	}
+}
+
+func TestEvalConditionHourBetween(t *testing.T) {
+	ctx := EvalContext{Request: map[string]string{"time": "2026-07-29T10:00:00Z"}}
+	ok, err := evalCondition("hour_between(9, 17)", ctx)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected hour 10 to be within [9, 17)")
+	}
+
+	ok, err = evalCondition("hour_between(9, 17)", EvalContext{Request: map[string]string{"time": "2026-07-29T20:00:00Z"}})
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if ok {
+		t.Fatal("expected hour 20 to be outside [9, 17)")
+	}
+}
+
+func TestEvalConditionHourBetweenWraps(t *testing.T) {
+	ctx := EvalContext{Request: map[string]string{"time": "2026-07-29T23:00:00Z"}}
+	ok, err := evalCondition("hour_between(22, 6)", ctx)
+	if err != nil {
+		t.Fatalf("evalCondition: %v", err)
+	}
+	if !ok {
+		t.Fatal("expected hour 23 to be within the wrapping window [22, 6)")
+	}
+}
+
+func TestEvalConditionUnknownAttribute(t *testing.T) {
+	if _, err := evalCondition("resource.owner == subject.id", EvalContext{}); err == nil {
+		t.Fatal("expected an error for a condition referencing a missing attribute")
+	}
+}
@@ -0,0 +1,22 @@
+package resource
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// decisionsTotal counts Check outcomes by result ("allow", "deny",
+// "error"), for dashboards and alerting on unexpected deny/error rates.
+var decisionsTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "authz",
+		Name:      "decisions_total",
+		Help:      "Total number of authorization decisions, by result.",
+	},
+	[]string{"result"},
+)
+
+func init() {
+	prometheus.MustRegister(decisionsTotal)
+}
+
+func recordDecision(result string) {
+	decisionsTotal.WithLabelValues(result).Inc()
+}
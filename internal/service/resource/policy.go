@@ -0,0 +1,103 @@
+package resource
+
+import "strings"
+
+// Effect is the outcome a Policy grants when it matches.
+type Effect string
+
+const (
+	Allow Effect = "allow"
+	Deny  Effect = "deny"
+)
+
+// Policy is a single (subject, service, resource, action, effect)
+// authorization rule, optionally narrowed by Conditions.
+//
+// Resource supports glob matching ("docs/*") and hierarchical
+// inheritance: a policy on "docs/*" also grants "docs/a/b". Subject and
+// Action support the literal wildcard "*".
+type Policy struct {
+	ID       string
+	Subject  string
+	Service  string
+	Resource string
+	Action   string
+	Effect   Effect
+
+	// Conditions are attribute-based expressions, e.g.
+	// `resource.owner == subject.id`, that must all evaluate true for the
+	// policy to apply. See expr.go.
+	Conditions []string
+}
+
+// Decision is the result of evaluating all policies for a request.
+type Decision struct {
+	Effect  Effect
+	// MatchedPolicyID is the id of the policy that determined the
+	// decision, empty when Effect is Deny by default (no matching
+	// policy).
+	MatchedPolicyID string
+}
+
+// matchesSubject reports whether the policy applies to subject.
+func (p *Policy) matchesSubject(subject string) bool {
+	return p.Subject == "*" || p.Subject == subject
+}
+
+// matchesAction reports whether the policy applies to action.
+func (p *Policy) matchesAction(action string) bool {
+	return p.Action == "*" || p.Action == action
+}
+
+// matchesService reports whether the policy applies to service.
+func (p *Policy) matchesService(service string) bool {
+	return p.Service == "*" || p.Service == service
+}
+
+// matchesResource reports whether the policy's resource pattern matches
+// resourcePath, honoring a trailing "/*" as matching the prefix and
+// everything hierarchically beneath it.
+func (p *Policy) matchesResource(resourcePath string) bool {
+	pattern := p.Resource
+	if pattern == "*" {
+		return true
+	}
+	if !strings.Contains(pattern, "*") {
+		return pattern == resourcePath
+	}
+	if strings.HasSuffix(pattern, "/*") {
+		prefix := strings.TrimSuffix(pattern, "/*")
+		return resourcePath == prefix || strings.HasPrefix(resourcePath, prefix+"/")
+	}
+	return globMatch(pattern, resourcePath)
+}
+
+// globMatch implements the subset of shell glob needed for resource
+// paths: "*" matches any run of characters within a single path (it does
+// not stop at "/", matching the "docs/*" style patterns above already
+// handled as a prefix match).
+func globMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(s, part)
+		if idx < 0 {
+			return false
+		}
+		s = s[idx+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
+
+// specificity scores a policy's resource pattern so the most specific
+// matching policy can be preferred when policies conflict (narrower
+// patterns win over broader ones, e.g. "docs/a/*" over "docs/*").
+func (p *Policy) specificity() int {
+	return len(strings.TrimSuffix(p.Resource, "/*"))
+}
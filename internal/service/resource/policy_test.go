@@ -0,0 +1,63 @@
+package resource
+
+import "testing"
+
+func TestMatchesResource(t *testing.T) {
+	tests := []struct {
+		pattern, path string
+		want          bool
+	}{
+		{"*", "anything", true},
+		{"docs/readme", "docs/readme", true},
+		{"docs/readme", "docs/other", false},
+		{"docs/*", "docs", true},
+		{"docs/*", "docs/a", true},
+		{"docs/*", "docs/a/b", true},
+		{"docs/*", "other", false},
+		{"docs/*.md", "docs/readme.md", true},
+		{"docs/*.md", "docs/readme.txt", false},
+	}
+	for _, tt := range tests {
+		p := &Policy{Resource: tt.pattern}
+		if got := p.matchesResource(tt.path); got != tt.want {
+			t.Errorf("Policy{Resource: %q}.matchesResource(%q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSpecificityPrefersNarrowerPattern(t *testing.T) {
+	broad := &Policy{Resource: "docs/*"}
+	narrow := &Policy{Resource: "docs/a/*"}
+	if narrow.specificity() <= broad.specificity() {
+		t.Fatalf("narrow pattern %q should be more specific than %q", narrow.Resource, broad.Resource)
+	}
+}
+
+func TestResolveDecisionDenyOverridesAllow(t *testing.T) {
+	matched := []*Policy{
+		{ID: "allow-specific", Effect: Allow, Resource: "docs/a/*"},
+		{ID: "deny-broad", Effect: Deny, Resource: "docs/*"},
+	}
+	d := resolveDecision(matched)
+	if d.Effect != Deny || d.MatchedPolicyID != "deny-broad" {
+		t.Fatalf("resolveDecision = %+v, want Deny matched by deny-broad", d)
+	}
+}
+
+func TestResolveDecisionMostSpecificAllowWins(t *testing.T) {
+	matched := []*Policy{
+		{ID: "allow-broad", Effect: Allow, Resource: "docs/*"},
+		{ID: "allow-specific", Effect: Allow, Resource: "docs/a/*"},
+	}
+	d := resolveDecision(matched)
+	if d.Effect != Allow || d.MatchedPolicyID != "allow-specific" {
+		t.Fatalf("resolveDecision = %+v, want Allow matched by allow-specific", d)
+	}
+}
+
+func TestResolveDecisionDefaultsToDeny(t *testing.T) {
+	d := resolveDecision(nil)
+	if d.Effect != Deny || d.MatchedPolicyID != "" {
+		t.Fatalf("resolveDecision(nil) = %+v, want zero-value Deny", d)
+	}
+}
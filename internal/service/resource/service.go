@@ -0,0 +1,184 @@
+// Package resource implements a policy-based authorization engine: given
+// a (subject, service, resource, action) tuple it decides whether the
+// request is allowed, evaluating glob/hierarchical resource matching and
+// attribute-based conditions over a cached set of policies.
+package resource
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// ErrNotFound is returned when a lookup matches no policy.
+var ErrNotFound = errors.New("resource: policy not found")
+
+const defaultCacheCapacity = 10000
+
+// PolicyStore is the persistence backend for policies.
+type PolicyStore interface {
+	Get(ctx context.Context, id string) (*Policy, error)
+	List(ctx context.Context, service string) ([]*Policy, error)
+	Create(ctx context.Context, p *Policy) error
+	Update(ctx context.Context, p *Policy) error
+	Delete(ctx context.Context, id string) error
+}
+
+// Service is the policy engine: CRUD over Policies plus the Check API
+// that evaluates them for a single authorization request.
+type Service struct {
+	store PolicyStore
+	cache *decisionCache
+}
+
+// NewService constructs a Service backed by store, with an in-memory LRU
+// decision cache sized to cacheCapacity (0 selects a sensible default).
+func NewService(store PolicyStore, cacheCapacity int) *Service {
+	if cacheCapacity <= 0 {
+		cacheCapacity = defaultCacheCapacity
+	}
+	return &Service{store: store, cache: newDecisionCache(cacheCapacity)}
+}
+
+// Get returns the policy with the given id.
+func (s *Service) Get(ctx context.Context, id string) (*Policy, error) {
+	return s.store.Get(ctx, id)
+}
+
+// List returns all policies scoped to service.
+func (s *Service) List(ctx context.Context, service string) ([]*Policy, error) {
+	return s.store.List(ctx, service)
+}
+
+// Create adds a new policy and invalidates the decision cache.
+func (s *Service) Create(ctx context.Context, p *Policy) error {
+	if err := s.store.Create(ctx, p); err != nil {
+		return err
+	}
+	s.cache.invalidateAll()
+	return nil
+}
+
+// Update replaces an existing policy and invalidates the decision cache.
+func (s *Service) Update(ctx context.Context, p *Policy) error {
+	if err := s.store.Update(ctx, p); err != nil {
+		return err
+	}
+	s.cache.invalidateAll()
+	return nil
+}
+
+// Delete removes a policy and invalidates the decision cache.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	if err := s.store.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.cache.invalidateAll()
+	return nil
+}
+
+// Check decides whether subject may perform action on resourcePath within
+// service, evaluating every matching policy's conditions against attrs.
+// An explicit Deny policy always wins over an Allow, even a more
+// specific one, since deny-overrides is the safer default for an
+// authorization gate. With no matching policy the decision is Deny.
+func (s *Service) Check(ctx context.Context, subject, service, resourcePath, action string, attrs EvalContext) (Decision, error) {
+	if attrs.Subject == nil {
+		attrs.Subject = make(map[string]string)
+	}
+	attrs.Subject["id"] = subject
+
+	key := decisionCacheKey(subject, service, resourcePath, action, attrs)
+	if d, ok := s.cache.get(key); ok {
+		recordDecision(string(d.Effect))
+		return d, nil
+	}
+
+	policies, err := s.store.List(ctx, service)
+	if err != nil {
+		recordDecision("error")
+		return Decision{}, err
+	}
+
+	var matched []*Policy
+	for _, p := range policies {
+		if !p.matchesSubject(subject) || !p.matchesService(service) || !p.matchesAction(action) || !p.matchesResource(resourcePath) {
+			continue
+		}
+		ok, err := conditionsSatisfied(p.Conditions, attrs)
+		if err != nil {
+			recordDecision("error")
+			return Decision{}, err
+		}
+		if ok {
+			matched = append(matched, p)
+		}
+	}
+
+	decision := resolveDecision(matched)
+	s.cache.put(key, decision)
+	recordDecision(string(decision.Effect))
+	return decision, nil
+}
+
+func conditionsSatisfied(conditions []string, attrs EvalContext) (bool, error) {
+	for _, cond := range conditions {
+		ok, err := evalCondition(cond, attrs)
+		if errors.Is(err, errMissingAttribute) {
+			// The request didn't supply an attribute this condition
+			// needs (e.g. no request.ip on an internal call) — treat
+			// the policy as not matching rather than failing the
+			// whole Check/BatchCheck over it.
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// resolveDecision combines matched policies: any Deny wins; otherwise the
+// most specific Allow wins; with nothing matched the default is Deny.
+func resolveDecision(matched []*Policy) Decision {
+	for _, p := range matched {
+		if p.Effect == Deny {
+			return Decision{Effect: Deny, MatchedPolicyID: p.ID}
+		}
+	}
+	if len(matched) == 0 {
+		return Decision{Effect: Deny}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].specificity() > matched[j].specificity()
+	})
+	return Decision{Effect: Allow, MatchedPolicyID: matched[0].ID}
+}
+
+// CheckRequest is a single tuple to evaluate via BatchCheck.
+type CheckRequest struct {
+	Subject      string
+	Service      string
+	ResourcePath string
+	Action       string
+	Attrs        EvalContext
+}
+
+// BatchCheck evaluates many requests in one call, so a gateway can
+// authorize N calls in a single round trip instead of one Check per
+// call.
+func (s *Service) BatchCheck(ctx context.Context, reqs []CheckRequest) ([]Decision, error) {
+	decisions := make([]Decision, len(reqs))
+	for i, req := range reqs {
+		d, err := s.Check(ctx, req.Subject, req.Service, req.ResourcePath, req.Action, req.Attrs)
+		if err != nil {
+			return nil, err
+		}
+		decisions[i] = d
+	}
+	return decisions, nil
+}
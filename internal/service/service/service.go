@@ -0,0 +1,51 @@
+// Package service manages the registry of client services whose
+// resources are protected by the resource authorization subsystem. A
+// registered service here is a tenant such as "billing-api" or
+// "docs-wiki" — resource policies are scoped to one of these by id.
+package service
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when a lookup matches no registered service.
+var ErrNotFound = errors.New("service: not found")
+
+// Record is a registered client service.
+type Record struct {
+	ID   string
+	Name string
+}
+
+// Store is the persistence backend for registered services.
+type Store interface {
+	Get(ctx context.Context, id string) (*Record, error)
+	List(ctx context.Context) ([]*Record, error)
+	Create(ctx context.Context, r *Record) error
+}
+
+// Service manages the registry of client services.
+type Service struct {
+	store Store
+}
+
+// NewService constructs a Service backed by store.
+func NewService(store Store) *Service {
+	return &Service{store: store}
+}
+
+// Get returns the registered service with the given id.
+func (s *Service) Get(ctx context.Context, id string) (*Record, error) {
+	return s.store.Get(ctx, id)
+}
+
+// List returns all registered services.
+func (s *Service) List(ctx context.Context) ([]*Record, error) {
+	return s.store.List(ctx)
+}
+
+// Register adds a new client service to the registry.
+func (s *Service) Register(ctx context.Context, r *Record) error {
+	return s.store.Create(ctx, r)
+}
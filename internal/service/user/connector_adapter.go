@@ -0,0 +1,79 @@
+package user
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-sso-example/auth-service/internal/connector"
+)
+
+// connectorStoreAdapter satisfies Store by delegating to a
+// connector.UserStore loaded via the connector registry, translating
+// between connector.User and this package's User so the service layer
+// never depends on the connector package directly.
+type connectorStoreAdapter struct {
+	store connector.UserStore
+}
+
+// NewStoreFromConnector wraps a connector.UserStore (as built by
+// connector.Load from the service's `connectors:` config) as a Store,
+// so it can back NewService the same way any hand-written Store would.
+func NewStoreFromConnector(store connector.UserStore) Store {
+	return &connectorStoreAdapter{store: store}
+}
+
+func (a *connectorStoreAdapter) Get(ctx context.Context, id string) (*User, error) {
+	u, err := a.store.Get(ctx, id)
+	if err != nil {
+		return nil, mapNotFound(err)
+	}
+	return fromConnectorUser(u), nil
+}
+
+func (a *connectorStoreAdapter) GetByExternalIdentity(ctx context.Context, provider, subject string) (*User, error) {
+	u, err := a.store.GetByExternalIdentity(ctx, provider, subject)
+	if err != nil {
+		return nil, mapNotFound(err)
+	}
+	return fromConnectorUser(u), nil
+}
+
+func mapNotFound(err error) error {
+	if errors.Is(err, connector.ErrNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+func (a *connectorStoreAdapter) Create(ctx context.Context, u *User) error {
+	cu := toConnectorUser(u)
+	if err := a.store.Create(ctx, cu); err != nil {
+		return err
+	}
+	u.ID = cu.ID
+	return nil
+}
+
+func (a *connectorStoreAdapter) Update(ctx context.Context, u *User) error {
+	return a.store.Update(ctx, toConnectorUser(u))
+}
+
+func fromConnectorUser(u *connector.User) *User {
+	return &User{
+		ID:                 u.ID,
+		Username:           u.Username,
+		Email:              u.Email,
+		PasswordHash:       u.PasswordHash,
+		ExternalIdentities: u.ExternalIdentities,
+	}
+}
+
+func toConnectorUser(u *User) *connector.User {
+	return &connector.User{
+		ID:                 u.ID,
+		Username:           u.Username,
+		Email:              u.Email,
+		PasswordHash:       u.PasswordHash,
+		ExternalIdentities: u.ExternalIdentities,
+	}
+}
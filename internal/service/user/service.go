@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when a lookup matches no user.
+var ErrNotFound = errors.New("user: not found")
+
+// User is a local account record.
+type User struct {
+	ID       string
+	Username string
+	Email    string
+
+	// PasswordHash is empty for accounts provisioned solely through an
+	// external identity provider.
+	PasswordHash string
+
+	// ExternalIdentities maps a provider name (e.g. "google", "github") to
+	// the subject identifier reported by that provider, so repeat logins
+	// resolve back to the same local account.
+	ExternalIdentities map[string]string
+}
+
+// Store is the persistence backend for users.
+type Store interface {
+	Get(ctx context.Context, id string) (*User, error)
+	GetByExternalIdentity(ctx context.Context, provider, subject string) (*User, error)
+	Create(ctx context.Context, u *User) error
+	Update(ctx context.Context, u *User) error
+}
+
+// Service manages local user accounts.
+type Service struct {
+	store Store
+}
+
+// NewService constructs a user Service backed by store.
+func NewService(store Store) *Service {
+	return &Service{store: store}
+}
+
+// Get returns the user with the given id.
+func (s *Service) Get(ctx context.Context, id string) (*User, error) {
+	return s.store.Get(ctx, id)
+}
+
+// GetByExternalIdentity looks up the local user previously linked to the
+// given provider/subject pair, if any.
+func (s *Service) GetByExternalIdentity(ctx context.Context, provider, subject string) (*User, error) {
+	return s.store.GetByExternalIdentity(ctx, provider, subject)
+}
+
+// LinkExternalIdentity associates an external provider subject with an
+// existing local user.
+func (s *Service) LinkExternalIdentity(ctx context.Context, u *User, provider, subject string) error {
+	if u.ExternalIdentities == nil {
+		u.ExternalIdentities = make(map[string]string)
+	}
+	u.ExternalIdentities[provider] = subject
+	return s.store.Update(ctx, u)
+}
+
+// CreateFromExternalIdentity provisions a new local user for a first-time
+// external login.
+func (s *Service) CreateFromExternalIdentity(ctx context.Context, provider, subject, email string) (*User, error) {
+	u := &User{
+		Username:           email,
+		Email:              email,
+		ExternalIdentities: map[string]string{provider: subject},
+	}
+	if err := s.store.Create(ctx, u); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
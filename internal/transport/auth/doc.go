@@ -0,0 +1,5 @@
+// Package auth exposes the HTTP surface for local and external provider
+// login.
+//
+// @Tag auth Login and session endpoints
+package auth
@@ -1,6 +1,16 @@
 package auth
 
-import "github.com/go-sso-example/auth-service/internal/service/auth"
+import (
+	"net/http"
+
+	"github.com/go-sso-example/auth-service/internal/connector"
+	"github.com/go-sso-example/auth-service/internal/httpapi/jsonapi"
+	"github.com/go-sso-example/auth-service/internal/service/auth"
+)
+
+// stateCookieName is the short-lived cookie used to bind an external
+// login's callback to the request that started it.
+const stateCookieName = "sso_login_state"
 
 type APIHandler struct {
 	authService *auth.Service
@@ -11,3 +21,146 @@ func NewAPIHandler(authService *auth.Service) *APIHandler {
 		authService: authService,
 	}
 }
+
+// RegisterRoutes wires the handler's endpoints onto mux, including a
+// single /auth/{provider}/login and /auth/{provider}/callback pair that
+// serves every configured external provider by name.
+func (h *APIHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/auth/{provider}/login", h.ProviderLogin)
+	mux.HandleFunc("/auth/{provider}/callback", h.ProviderCallback)
+	mux.HandleFunc("POST /auth/login", h.Login)
+}
+
+// SessionAttributes is the JSON:API "attributes" object returned by a
+// successful login, local or external.
+type SessionAttributes struct {
+	Token string `json:"token"`
+}
+
+// ProviderLogin redirects the client to the named external provider's
+// authorization endpoint, stashing the signed login state in a cookie so
+// ProviderCallback can verify it on return.
+//
+// @Route GET /auth/{provider}/login
+// @Tag auth
+// @Param provider path string required "Provider name, e.g. google, github"
+// @Success 302 {string} none "Redirect to the provider's authorization endpoint"
+func (h *APIHandler) ProviderLogin(w http.ResponseWriter, r *http.Request) {
+	providerName := r.PathValue("provider")
+
+	redirectURL, state, err := h.authService.BeginLogin(r.Context(), providerName)
+	if err != nil {
+		if err == auth.ErrUnknownProvider {
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusNotFound, "Not Found", err.Error()))
+			return
+		}
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusInternalServerError, "Internal Server Error", err.Error()))
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookieName,
+		Value:    state,
+		Path:     "/auth/" + providerName,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, redirectURL, http.StatusFound)
+}
+
+// ProviderCallback completes the OAuth2 code exchange for the named
+// provider, maps the resulting identity onto a local user, and issues the
+// same session JWT the local login path issues.
+//
+// @Route GET /auth/{provider}/callback
+// @Tag auth
+// @Param provider path string required "Provider name, e.g. google, github"
+// @Param code query string required "Authorization code returned by the provider"
+// @Param state query string required "Signed state returned by the provider"
+// @Success 200 {object} SessionAttributes
+func (h *APIHandler) ProviderCallback(w http.ResponseWriter, r *http.Request) {
+	providerName := r.PathValue("provider")
+
+	cookie, err := r.Cookie(stateCookieName)
+	if err != nil {
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusBadRequest, "Bad Request", "missing login state cookie"))
+		return
+	}
+
+	code := r.URL.Query().Get("code")
+	state := r.URL.Query().Get("state")
+
+	token, _, err := h.authService.CompleteLogin(r.Context(), providerName, code, state, cookie.Value)
+	if err != nil {
+		switch err {
+		case auth.ErrUnknownProvider:
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusNotFound, "Not Found", err.Error()))
+		case auth.ErrProvisioningDisabled:
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusForbidden, "Forbidden", err.Error()))
+		default:
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusUnauthorized, "Unauthorized", err.Error()))
+		}
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     stateCookieName,
+		Value:    "",
+		Path:     "/auth/" + providerName,
+		MaxAge:   -1,
+		HttpOnly: true,
+	})
+
+	jsonapi.WriteResource(w, http.StatusOK, &jsonapi.Resource{
+		Type:       "sessions",
+		Attributes: SessionAttributes{Token: token},
+	})
+}
+
+// LoginAttributes is the JSON:API "attributes" object accepted by Login.
+type LoginAttributes struct {
+	ConnectorID string `json:"connector_id"`
+	Username    string `json:"username"`
+	Password    string `json:"password"`
+}
+
+// Login authenticates username/password credentials against the named
+// identity connector (e.g. LDAP) and, on success,
+// issues the same session JWT an external provider login issues. The
+// connector to authenticate against is named explicitly by the caller
+// rather than tried in order, so a deployment federating multiple
+// connectors (e.g. LDAP employees and a GitHub-backed contractor list)
+// never has to worry about one connector shadowing another.
+//
+// @Route POST /auth/login
+// @Tag auth
+// @Success 200 {object} SessionAttributes
+func (h *APIHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var attrs LoginAttributes
+	if _, _, err := jsonapi.Decode(r, &attrs); err != nil {
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusBadRequest, "Bad Request", err.Error()))
+		return
+	}
+
+	token, err := h.authService.AuthenticateWithConnector(r.Context(), attrs.ConnectorID, connector.Credentials{
+		Username: attrs.Username,
+		Password: attrs.Password,
+	})
+	if err != nil {
+		switch err {
+		case auth.ErrUnknownConnector:
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusNotFound, "Not Found", err.Error()))
+		case auth.ErrProvisioningDisabled:
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusForbidden, "Forbidden", err.Error()))
+		default:
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusUnauthorized, "Unauthorized", err.Error()))
+		}
+		return
+	}
+
+	jsonapi.WriteResource(w, http.StatusOK, &jsonapi.Resource{
+		Type:       "sessions",
+		Attributes: SessionAttributes{Token: token},
+	})
+}
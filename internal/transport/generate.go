@@ -0,0 +1,7 @@
+// Package transport is the parent of the HTTP handler packages
+// (internal/transport/auth, internal/transport/resource, ...). It holds
+// no code of its own beyond the go:generate directive that regenerates
+// openapi.yaml from their handler annotations.
+package transport
+
+//go:generate go run ../../cmd/gen-openapi -out ../../openapi.yaml github.com/go-sso-example/auth-service/internal/transport/auth github.com/go-sso-example/auth-service/internal/transport/resource
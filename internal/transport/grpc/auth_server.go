@@ -0,0 +1,49 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authv1 "github.com/go-sso-example/auth-service/proto/auth/v1"
+
+	"github.com/go-sso-example/auth-service/internal/service/auth"
+)
+
+// AuthServer adapts auth.Service onto the generated AuthServiceServer
+// interface. It holds no state of its own beyond the auth.Service it
+// delegates to, the same pattern internal/transport/auth.APIHandler uses
+// for the REST surface.
+type AuthServer struct {
+	authv1.UnimplementedAuthServiceServer
+
+	authService *auth.Service
+}
+
+// NewAuthServer constructs an AuthServer backed by authService.
+func NewAuthServer(authService *auth.Service) *AuthServer {
+	return &AuthServer{authService: authService}
+}
+
+func (s *AuthServer) BeginProviderLogin(ctx context.Context, req *authv1.BeginProviderLoginRequest) (*authv1.BeginProviderLoginResponse, error) {
+	redirectURL, state, err := s.authService.BeginLogin(ctx, req.GetProvider())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &authv1.BeginProviderLoginResponse{RedirectUrl: redirectURL, State: state}, nil
+}
+
+func (s *AuthServer) CompleteProviderLogin(ctx context.Context, req *authv1.CompleteProviderLoginRequest) (*authv1.SessionResponse, error) {
+	token, _, err := s.authService.CompleteLogin(ctx, req.GetProvider(), req.GetCode(), req.GetState(), req.GetCookieState())
+	if err != nil {
+		// Like the REST callback, any failure other than an unknown
+		// provider or disabled provisioning is an authentication failure.
+		if errors.Is(err, auth.ErrUnknownProvider) || errors.Is(err, auth.ErrProvisioningDisabled) {
+			return nil, grpcError(err)
+		}
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return &authv1.SessionResponse{Token: token}, nil
+}
@@ -0,0 +1,28 @@
+package grpcserver
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authsvc "github.com/go-sso-example/auth-service/internal/service/auth"
+	resourcesvc "github.com/go-sso-example/auth-service/internal/service/resource"
+	usersvc "github.com/go-sso-example/auth-service/internal/service/user"
+)
+
+// grpcError maps a service-layer error to the gRPC status code an
+// equivalent REST handler would map it to as an HTTP status, so callers
+// on either transport see the same class of failure.
+func grpcError(err error) error {
+	switch {
+	case errors.Is(err, resourcesvc.ErrNotFound), errors.Is(err, usersvc.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, authsvc.ErrUnknownProvider):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, authsvc.ErrProvisioningDisabled):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,38 @@
+package grpcserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	authv1 "github.com/go-sso-example/auth-service/proto/auth/v1"
+	resourcev1 "github.com/go-sso-example/auth-service/proto/resource/v1"
+)
+
+// NewGatewayMux returns an http.Handler that reverse-proxies REST
+// requests to the gRPC server listening at grpcAddr, translating them to
+// the AuthService/ResourceService RPCs via the grpc-gateway stubs
+// generated from proto/auth/v1 and proto/resource/v1. It forwards the
+// incoming "Authorization" header as gRPC metadata, so the gRPC auth
+// interceptor authenticates gateway-proxied calls identically to direct
+// gRPC calls.
+//
+// Existing browser/CLI callers keep using the handwritten REST handlers
+// in internal/transport/auth and internal/transport/resource; this
+// gateway exists for the paths that only the generated proto types
+// cover (currently none — it is additive, not a replacement).
+func NewGatewayMux(ctx context.Context, grpcAddr string) (http.Handler, error) {
+	mux := runtime.NewServeMux()
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+
+	if err := authv1.RegisterAuthServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+	if err := resourcev1.RegisterResourceServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
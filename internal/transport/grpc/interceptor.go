@@ -0,0 +1,67 @@
+// Package grpcserver adapts service.Service (auth and resource) onto gRPC
+// servers that mirror the HTTP handlers in internal/transport/auth and
+// internal/transport/resource, sharing the same JWT authentication as
+// the HTTP mux via a unary interceptor.
+package grpcserver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-sso-example/auth-service/internal/httpapi/authctx"
+	"github.com/go-sso-example/auth-service/internal/service/auth"
+)
+
+// publicMethods lists the fully-qualified gRPC methods that don't
+// require a bearer token, mirroring the HTTP login/callback routes that
+// are reachable without one.
+var publicMethods = map[string]bool{
+	"/auth.v1.AuthService/BeginProviderLogin":    true,
+	"/auth.v1.AuthService/CompleteProviderLogin": true,
+}
+
+// AuthInterceptor returns a grpc.UnaryServerInterceptor that extracts a
+// bearer token from the "authorization" metadata key, verifies it with
+// verifier, and injects the resulting subject into the request context
+// via authctx. Methods listed in publicMethods are let through without a
+// token.
+func AuthInterceptor(verifier auth.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if publicMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		token, err := bearerTokenFromMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		subject, err := verifier.Verify(ctx, token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "invalid bearer token")
+		}
+
+		ctx = authctx.WithSubject(ctx, authctx.Subject{ID: subject})
+		return handler(ctx, req)
+	}
+}
+
+func bearerTokenFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing authorization metadata")
+	}
+	const prefix = "Bearer "
+	if len(values[0]) <= len(prefix) || values[0][:len(prefix)] != prefix {
+		return "", status.Error(codes.Unauthenticated, `authorization metadata must be "Bearer <token>"`)
+	}
+	return values[0][len(prefix):], nil
+}
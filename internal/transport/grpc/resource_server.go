@@ -0,0 +1,117 @@
+package grpcserver
+
+import (
+	"context"
+
+	resourcev1 "github.com/go-sso-example/auth-service/proto/resource/v1"
+
+	"github.com/go-sso-example/auth-service/internal/service/resource"
+)
+
+// ResourceServer adapts resource.Service onto the generated
+// ResourceServiceServer interface.
+type ResourceServer struct {
+	resourcev1.UnimplementedResourceServiceServer
+
+	resourceService *resource.Service
+}
+
+// NewResourceServer constructs a ResourceServer backed by resourceService.
+func NewResourceServer(resourceService *resource.Service) *ResourceServer {
+	return &ResourceServer{resourceService: resourceService}
+}
+
+func (s *ResourceServer) ListPolicies(ctx context.Context, req *resourcev1.ListPoliciesRequest) (*resourcev1.ListPoliciesResponse, error) {
+	policies, err := s.resourceService.List(ctx, req.GetService())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	out := make([]*resourcev1.Policy, len(policies))
+	for i, p := range policies {
+		out[i] = toProtoPolicy(p)
+	}
+	return &resourcev1.ListPoliciesResponse{Policies: out}, nil
+}
+
+func (s *ResourceServer) GetPolicy(ctx context.Context, req *resourcev1.GetPolicyRequest) (*resourcev1.Policy, error) {
+	p, err := s.resourceService.Get(ctx, req.GetId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoPolicy(p), nil
+}
+
+func (s *ResourceServer) CreatePolicy(ctx context.Context, req *resourcev1.CreatePolicyRequest) (*resourcev1.Policy, error) {
+	p := fromProtoPolicy(req.GetPolicy())
+	if err := s.resourceService.Create(ctx, p); err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoPolicy(p), nil
+}
+
+func (s *ResourceServer) UpdatePolicy(ctx context.Context, req *resourcev1.UpdatePolicyRequest) (*resourcev1.Policy, error) {
+	p := fromProtoPolicy(req.GetPolicy())
+	p.ID = req.GetId()
+	if err := s.resourceService.Update(ctx, p); err != nil {
+		return nil, grpcError(err)
+	}
+	return toProtoPolicy(p), nil
+}
+
+func (s *ResourceServer) DeletePolicy(ctx context.Context, req *resourcev1.DeletePolicyRequest) (*resourcev1.DeletePolicyResponse, error) {
+	if err := s.resourceService.Delete(ctx, req.GetId()); err != nil {
+		return nil, grpcError(err)
+	}
+	return &resourcev1.DeletePolicyResponse{}, nil
+}
+
+func (s *ResourceServer) Check(ctx context.Context, req *resourcev1.CheckRequest) (*resourcev1.CheckResponse, error) {
+	checks := make([]resource.CheckRequest, len(req.GetChecks()))
+	for i, c := range req.GetChecks() {
+		checks[i] = resource.CheckRequest{
+			Subject:      c.GetSubject(),
+			Service:      c.GetService(),
+			ResourcePath: c.GetResource(),
+			Action:       c.GetAction(),
+			Attrs: resource.EvalContext{
+				Resource: c.GetResourceAttrs(),
+				Request:  c.GetRequestAttrs(),
+			},
+		}
+	}
+
+	decisions, err := s.resourceService.BatchCheck(ctx, checks)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+
+	out := make([]*resourcev1.Decision, len(decisions))
+	for i, d := range decisions {
+		out[i] = &resourcev1.Decision{Effect: string(d.Effect), MatchedPolicyId: d.MatchedPolicyID}
+	}
+	return &resourcev1.CheckResponse{Decisions: out}, nil
+}
+
+func toProtoPolicy(p *resource.Policy) *resourcev1.Policy {
+	return &resourcev1.Policy{
+		Id:         p.ID,
+		Subject:    p.Subject,
+		Service:    p.Service,
+		Resource:   p.Resource,
+		Action:     p.Action,
+		Effect:     string(p.Effect),
+		Conditions: p.Conditions,
+	}
+}
+
+func fromProtoPolicy(p *resourcev1.Policy) *resource.Policy {
+	return &resource.Policy{
+		ID:         p.GetId(),
+		Subject:    p.GetSubject(),
+		Service:    p.GetService(),
+		Resource:   p.GetResource(),
+		Action:     p.GetAction(),
+		Effect:     resource.Effect(p.GetEffect()),
+		Conditions: p.GetConditions(),
+	}
+}
@@ -0,0 +1,34 @@
+package grpcserver
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	authv1 "github.com/go-sso-example/auth-service/proto/auth/v1"
+	resourcev1 "github.com/go-sso-example/auth-service/proto/resource/v1"
+
+	"github.com/go-sso-example/auth-service/internal/service/auth"
+	"github.com/go-sso-example/auth-service/internal/service/resource"
+)
+
+// NewServer builds a *grpc.Server with the AuthService and
+// ResourceService adapters registered, the shared JWT auth interceptor
+// installed, and reflection plus the standard health-check service
+// enabled so operators can probe it with grpcurl/grpc_health_probe
+// without any service-specific tooling.
+func NewServer(authService *auth.Service, resourceService *resource.Service, verifier auth.TokenVerifier) *grpc.Server {
+	srv := grpc.NewServer(grpc.UnaryInterceptor(AuthInterceptor(verifier)))
+
+	authv1.RegisterAuthServiceServer(srv, NewAuthServer(authService))
+	resourcev1.RegisterResourceServiceServer(srv, NewResourceServer(resourceService))
+
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	reflection.Register(srv)
+
+	return srv
+}
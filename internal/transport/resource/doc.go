@@ -0,0 +1,6 @@
+// Package resource exposes the HTTP surface for policy CRUD and the
+// bulk authorization check endpoint.
+//
+// @Tag resource Policy and authorization-check endpoints
+// @SecurityScheme BearerAuth http bearer
+package resource
@@ -1,6 +1,10 @@
 package resource
 
 import (
+	"net/http"
+	"sort"
+
+	"github.com/go-sso-example/auth-service/internal/httpapi/jsonapi"
 	"github.com/go-sso-example/auth-service/internal/service/resource"
 	"github.com/go-sso-example/auth-service/internal/service/service"
 	"github.com/go-sso-example/auth-service/internal/service/user"
@@ -19,3 +23,331 @@ func NewAPIHandler(serviceService *service.Service, resourceService *resource.Se
 		userService:     userService,
 	}
 }
+
+// RegisterRoutes wires the policy CRUD endpoints and the bulk
+// authorization check endpoint onto mux.
+func (h *APIHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /policies", h.ListPolicies)
+	mux.HandleFunc("POST /policies", h.CreatePolicy)
+	mux.HandleFunc("GET /policies/{id}", h.GetPolicy)
+	mux.HandleFunc("PUT /policies/{id}", h.UpdatePolicy)
+	mux.HandleFunc("DELETE /policies/{id}", h.DeletePolicy)
+	mux.HandleFunc("POST /authz/check", h.BatchCheck)
+}
+
+// policyAttributes is the JSON:API "attributes" object for a "policies"
+// resource, and also the shape CreatePolicy/UpdatePolicy decode request
+// bodies into.
+type policyAttributes struct {
+	Subject    string   `json:"subject"`
+	Service    string   `json:"service"`
+	Resource   string   `json:"resource"`
+	Action     string   `json:"action"`
+	Effect     string   `json:"effect"`
+	Conditions []string `json:"conditions,omitempty"`
+}
+
+// parseEffect validates that s is one of the canonical Effect values, so
+// a policy can never be stored with an effect resolveDecision doesn't
+// know how to treat as deny (and would otherwise contribute to an
+// allow).
+func parseEffect(s string) (resource.Effect, error) {
+	switch resource.Effect(s) {
+	case resource.Allow, resource.Deny:
+		return resource.Effect(s), nil
+	default:
+		return "", jsonapi.NewError(http.StatusUnprocessableEntity, "Unprocessable Entity",
+			`effect must be "allow" or "deny"`).WithPointer("/data/attributes/effect")
+	}
+}
+
+func policyResource(p *resource.Policy, fields []string) *jsonapi.Resource {
+	attrs := policyAttributes{
+		Subject:    p.Subject,
+		Service:    p.Service,
+		Resource:   p.Resource,
+		Action:     p.Action,
+		Effect:     string(p.Effect),
+		Conditions: p.Conditions,
+	}
+	return &jsonapi.Resource{
+		Type:       "policies",
+		ID:         p.ID,
+		Attributes: sparseFields(attrs, fields),
+	}
+}
+
+// sparseFields returns attrs unchanged when fields is empty (the
+// "?fields[policies]=" query param was not set), otherwise narrows it to
+// a map containing only the requested attribute names.
+func sparseFields(attrs policyAttributes, fields []string) any {
+	if len(fields) == 0 {
+		return attrs
+	}
+	full := map[string]any{
+		"subject":    attrs.Subject,
+		"service":    attrs.Service,
+		"resource":   attrs.Resource,
+		"action":     attrs.Action,
+		"effect":     attrs.Effect,
+		"conditions": attrs.Conditions,
+	}
+	narrowed := make(map[string]any, len(fields))
+	for _, f := range fields {
+		if v, ok := full[f]; ok {
+			narrowed[f] = v
+		}
+	}
+	return narrowed
+}
+
+// @Route GET /policies
+// @Tag resource
+// @Security BearerAuth
+// @Param filter[service] query string "Restrict to policies scoped to this service"
+// @Param sort query string "Sort key: id or -id"
+// @Param fields[policies] query string "Sparse fieldset for the policies type"
+// @Success 200 {object} policyAttributes
+func (h *APIHandler) ListPolicies(w http.ResponseWriter, r *http.Request) {
+	params := jsonapi.ParseListParams(r.URL.Query())
+	if err := rejectUnsupportedListParams(params); err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+	if err := rejectUnsupportedSort(params.Sort); err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+
+	policies, err := h.resourceService.List(r.Context(), r.URL.Query().Get("filter[service]"))
+	if err != nil {
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusInternalServerError, "Internal Server Error", err.Error()))
+		return
+	}
+
+	applySort(policies, params.Sort)
+
+	data := make([]*jsonapi.Resource, len(policies))
+	for i, p := range policies {
+		data[i] = policyResource(p, params.Fields["policies"])
+	}
+	jsonapi.WriteResource(w, http.StatusOK, data)
+}
+
+// rejectUnsupportedListParams rejects "?include=" and "?page[cursor]=",
+// neither of which this handler implements: policies have no
+// relationships to include, and the store has no cursor-paginated query
+// to page through. Accepting and silently ignoring them would advertise
+// support the API doesn't have.
+func rejectUnsupportedListParams(params jsonapi.ListParams) *jsonapi.Error {
+	if len(params.Include) > 0 {
+		return jsonapi.NewError(http.StatusBadRequest, "Bad Request", "include is not supported: policies have no relationships")
+	}
+	if params.PageCursor != "" {
+		return jsonapi.NewError(http.StatusBadRequest, "Bad Request", "page[cursor] is not supported")
+	}
+	return nil
+}
+
+// rejectUnsupportedSort rejects any sort key other than "id", the only
+// field applySort knows how to order by.
+func rejectUnsupportedSort(keys []jsonapi.SortKey) *jsonapi.Error {
+	for _, k := range keys {
+		if k.Field != "id" {
+			return jsonapi.NewError(http.StatusBadRequest, "Bad Request", "unsupported sort key: "+k.Field)
+		}
+	}
+	return nil
+}
+
+// applySort orders policies in place by the requested sort keys. Only
+// "id" is a supported sort field; rejectUnsupportedSort rejects any
+// other key before this is called.
+func applySort(policies []*resource.Policy, keys []jsonapi.SortKey) {
+	for _, k := range keys {
+		if k.Field != "id" {
+			continue
+		}
+		sort.SliceStable(policies, func(i, j int) bool {
+			if k.Descending {
+				return policies[i].ID > policies[j].ID
+			}
+			return policies[i].ID < policies[j].ID
+		})
+		return
+	}
+}
+
+// @Route GET /policies/{id}
+// @Tag resource
+// @Security BearerAuth
+// @Param id path string required "Policy id"
+// @Success 200 {object} policyAttributes
+func (h *APIHandler) GetPolicy(w http.ResponseWriter, r *http.Request) {
+	params := jsonapi.ParseListParams(r.URL.Query())
+	if err := rejectUnsupportedListParams(params); err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+
+	p, err := h.resourceService.Get(r.Context(), r.PathValue("id"))
+	if err != nil {
+		if err == resource.ErrNotFound {
+			jsonapi.WriteError(w, jsonapi.NewError(http.StatusNotFound, "Not Found", err.Error()))
+			return
+		}
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusInternalServerError, "Internal Server Error", err.Error()))
+		return
+	}
+	jsonapi.WriteResource(w, http.StatusOK, policyResource(p, params.Fields["policies"]))
+}
+
+// @Route POST /policies
+// @Tag resource
+// @Security BearerAuth
+// @Param body body policyAttributes required "Policy to create"
+// @Success 201 {object} policyAttributes
+func (h *APIHandler) CreatePolicy(w http.ResponseWriter, r *http.Request) {
+	var attrs policyAttributes
+	_, _, err := jsonapi.Decode(r, &attrs)
+	if err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+
+	effect, err := parseEffect(attrs.Effect)
+	if err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+
+	p := &resource.Policy{
+		Subject:    attrs.Subject,
+		Service:    attrs.Service,
+		Resource:   attrs.Resource,
+		Action:     attrs.Action,
+		Effect:     effect,
+		Conditions: attrs.Conditions,
+	}
+	if err := h.resourceService.Create(r.Context(), p); err != nil {
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusInternalServerError, "Internal Server Error", err.Error()))
+		return
+	}
+	jsonapi.WriteResource(w, http.StatusCreated, policyResource(p, nil))
+}
+
+// @Route PUT /policies/{id}
+// @Tag resource
+// @Security BearerAuth
+// @Param id path string required "Policy id"
+// @Param body body policyAttributes required "Replacement policy"
+// @Success 200 {object} policyAttributes
+func (h *APIHandler) UpdatePolicy(w http.ResponseWriter, r *http.Request) {
+	var attrs policyAttributes
+	_, _, err := jsonapi.Decode(r, &attrs)
+	if err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+
+	effect, err := parseEffect(attrs.Effect)
+	if err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+
+	p := &resource.Policy{
+		ID:         r.PathValue("id"),
+		Subject:    attrs.Subject,
+		Service:    attrs.Service,
+		Resource:   attrs.Resource,
+		Action:     attrs.Action,
+		Effect:     effect,
+		Conditions: attrs.Conditions,
+	}
+	if err := h.resourceService.Update(r.Context(), p); err != nil {
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusInternalServerError, "Internal Server Error", err.Error()))
+		return
+	}
+	jsonapi.WriteResource(w, http.StatusOK, policyResource(p, nil))
+}
+
+// @Route DELETE /policies/{id}
+// @Tag resource
+// @Security BearerAuth
+// @Param id path string required "Policy id"
+// @Success 204 {string} none "Policy deleted"
+func (h *APIHandler) DeletePolicy(w http.ResponseWriter, r *http.Request) {
+	if err := h.resourceService.Delete(r.Context(), r.PathValue("id")); err != nil {
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusInternalServerError, "Internal Server Error", err.Error()))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// batchCheckAttributes is the request body for POST /authz/check: a set
+// of (subject, service, resource, action) tuples to authorize in one
+// round trip.
+type batchCheckAttributes struct {
+	Checks []struct {
+		Subject       string            `json:"subject"`
+		Service       string            `json:"service"`
+		Resource      string            `json:"resource"`
+		Action        string            `json:"action"`
+		ResourceAttrs map[string]string `json:"resource_attrs"`
+		RequestAttrs  map[string]string `json:"request_attrs"`
+	} `json:"checks"`
+}
+
+// decisionAttributes is the JSON:API "attributes" object of each
+// "authorization-decisions" resource returned by POST /authz/check.
+type decisionAttributes struct {
+	Effect          string `json:"effect"`
+	MatchedPolicyID string `json:"matched_policy_id"`
+}
+
+// @Route POST /authz/check
+// @Tag resource
+// @Security BearerAuth
+// @Param body body batchCheckAttributes required "Batch of authorization tuples to evaluate"
+// @Success 200 {object} decisionAttributes
+func (h *APIHandler) BatchCheck(w http.ResponseWriter, r *http.Request) {
+	var attrs batchCheckAttributes
+	if _, _, err := jsonapi.Decode(r, &attrs); err != nil {
+		jsonapi.WriteError(w, err)
+		return
+	}
+
+	reqs := make([]resource.CheckRequest, len(attrs.Checks))
+	for i, c := range attrs.Checks {
+		reqs[i] = resource.CheckRequest{
+			Subject:      c.Subject,
+			Service:      c.Service,
+			ResourcePath: c.Resource,
+			Action:       c.Action,
+			Attrs: resource.EvalContext{
+				Resource: c.ResourceAttrs,
+				Request:  c.RequestAttrs,
+			},
+		}
+	}
+
+	decisions, err := h.resourceService.BatchCheck(r.Context(), reqs)
+	if err != nil {
+		jsonapi.WriteError(w, jsonapi.NewError(http.StatusInternalServerError, "Internal Server Error", err.Error()))
+		return
+	}
+
+	data := make([]*jsonapi.Resource, len(decisions))
+	for i, d := range decisions {
+		data[i] = &jsonapi.Resource{
+			Type: "authorization-decisions",
+			ID:   attrs.Checks[i].Subject + ":" + attrs.Checks[i].Resource + ":" + attrs.Checks[i].Action,
+			Attributes: decisionAttributes{
+				Effect:          string(d.Effect),
+				MatchedPolicyID: d.MatchedPolicyID,
+			},
+		}
+	}
+	jsonapi.WriteResource(w, http.StatusOK, data)
+}
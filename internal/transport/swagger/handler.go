@@ -0,0 +1,68 @@
+// Package swagger serves the OpenAPI document generated by
+// cmd/gen-openapi alongside a Swagger UI that browses it.
+package swagger
+
+import (
+	"net/http"
+	"os"
+)
+
+// APIHandler serves the generated OpenAPI spec and a Swagger UI page
+// that points at it.
+type APIHandler struct {
+	// specPath is the filesystem path to the openapi.yaml produced by
+	// `go generate` (see internal/transport/generate.go).
+	specPath string
+}
+
+// NewAPIHandler constructs a handler that serves the OpenAPI document at
+// specPath.
+func NewAPIHandler(specPath string) *APIHandler {
+	return &APIHandler{specPath: specPath}
+}
+
+// RegisterRoutes wires /swagger (the UI) and /swagger/openapi.yaml (the
+// raw spec) onto mux.
+func (h *APIHandler) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /swagger", h.UI)
+	mux.HandleFunc("GET /swagger/openapi.yaml", h.Spec)
+}
+
+// Spec writes the generated OpenAPI document.
+func (h *APIHandler) Spec(w http.ResponseWriter, r *http.Request) {
+	data, err := os.ReadFile(h.specPath)
+	if err != nil {
+		http.Error(w, "openapi spec not generated: run `go generate ./...`", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.Write(data)
+}
+
+// UI serves a minimal Swagger UI page backed by the swagger-ui-dist CDN
+// bundle, pointed at Spec's endpoint.
+func (h *APIHandler) UI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(swaggerUIPage))
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>auth-service API</title>
+  <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/swagger-ui-dist/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://cdn.jsdelivr.net/npm/swagger-ui-dist/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function() {
+      SwaggerUIBundle({
+        url: "/swagger/openapi.yaml",
+        dom_id: "#swagger-ui",
+      });
+    };
+  </script>
+</body>
+</html>
+`